@@ -142,7 +142,7 @@ func Example() {
 	// framerates.
 	dropFrame := tc.FromFrames(15000, rate.F29_97Df)
 
-	// 00:08:20;18 @ 29.97 NTSC DF
+	// 00:08:20;16 @ 29.97 NTSC DF
 	// NTSC DF
 	fmt.Println(dropFrame)
 	fmt.Println(dropFrame.Rate().NTSC())
@@ -194,7 +194,7 @@ func Example() {
 	// REMAINDER: 00:00:00:01 @ 23.98 NTSC NDF
 	// -17:23:13:02 @ 23.98 NTSC NDF
 	// 17:23:13:02 @ 23.98 NTSC NDF
-	// 00:08:20;18 @ 29.97 NTSC DF
+	// 00:08:20;16 @ 29.97 NTSC DF
 	// NTSC DF
 	// 493200
 	// 01:00:00:00 @ 119.88 NTSC NDF
@@ -5,4 +5,5 @@ go 1.16
 require (
 	github.com/stretchr/testify v1.7.0
 	github.com/wadey/go-rounding v1.1.0
+	google.golang.org/protobuf v1.28.1
 )
@@ -0,0 +1,130 @@
+package rate
+
+import (
+	"math/big"
+)
+
+// Equal returns whether rate and other are the same Framerate, with the same playback,
+// NTSC value and ScanType. Framerate values must be compared with Equal rather than ==,
+// which compares the playback pointers.
+//
+// 29.97 NTSC NDF and 29.97 NTSC DF are not equal, since their timecode is counted
+// differently. Use Cmp to compare the playback speed alone.
+func (rate Framerate) Equal(other Framerate) bool {
+	if rate.playback == nil || other.playback == nil {
+		return rate.playback == nil && other.playback == nil
+	}
+
+	return rate.playback.Cmp(other.playback) == 0 &&
+		rate.ntsc == other.ntsc &&
+		rate.scan == other.scan
+}
+
+// Cmp compares the playback speed of rate and other and returns:
+//
+//   -1 if rate <  other
+//    0 if rate == other
+//   +1 if rate >  other
+//
+// Only the playback speed is compared, so 29.97 NTSC NDF and 29.97 NTSC DF are equal,
+// and 24 fps is greater than 23.98 NTSC NDF. The zero value is less than every other
+// Framerate, and equal to itself.
+func (rate Framerate) Cmp(other Framerate) int {
+	if rate.playback == nil || other.playback == nil {
+		switch {
+		case rate.playback != nil:
+			return 1
+		case other.playback != nil:
+			return -1
+		default:
+			return 0
+		}
+	}
+
+	return rate.playback.Cmp(other.playback)
+}
+
+/*
+FramesPerFrame returns the number of frames of rate that play back during a single
+frame of other.
+
+What it is
+
+When rates are mixed, like 48 fps material cut into a 24 fps timeline, each frame of
+the timeline spans a fixed number of frames of the source. For F48.FramesPerFrame(F24)
+this is 2, and for F24.FramesPerFrame(F23_98) it is 1001/1000, since a 23.98 frame
+lasts slightly longer than a 24 fps frame.
+
+Where you see it
+
+• Frame blending and cadence decisions when conforming mixed-rate timelines.
+
+• High framerate deliverables, which are made by taking every nth frame.
+
+Note: this method will panic if rate or other is the zero value, or other has a playback
+of 0.
+*/
+func (rate Framerate) FramesPerFrame(other Framerate) *big.Rat {
+	if rate.playback == nil || other.playback == nil {
+		panic("FramesPerFrame cannot use the zero value Framerate")
+	}
+	return new(big.Rat).Quo(rate.playback, other.playback)
+}
+
+/*
+CommonTimebase returns the slowest rate, in ticks-per-second, at which a single frame of
+every one of rates lasts a whole number of ticks.
+
+What it is
+
+Timelines that mix framerates cannot count time in the frames of any one rate without
+rounding. Counting in ticks of the common timebase instead keeps all math in integers:
+the CommonTimebase of 24000/1001 and 25 is 24000, where a 23.98 frame lasts 1001
+ticks, and a 25 fps frame lasts 960 ticks.
+
+The value is the least common multiple of the playback numerators, over the greatest
+common divisor of the playback denominators. Only the playback speed of each rate is
+used.
+
+Where you see it
+
+• Editing systems and file formats that choose a single time scale for every track, like
+a QuickTime movie time scale.
+
+Note: this function will panic if no rates are passed, or any rate is the zero value or
+has a playback of 0.
+*/
+func CommonTimebase(rates ...Framerate) *big.Rat {
+	if len(rates) == 0 {
+		panic("CommonTimebase requires at least one Framerate")
+	}
+
+	num := new(big.Int)
+	denom := new(big.Int)
+	for _, rate := range rates {
+		if rate.playback == nil {
+			panic("CommonTimebase cannot use the zero value Framerate")
+		}
+		if rate.playback.Sign() == 0 {
+			panic("CommonTimebase cannot use a Framerate with a playback of 0")
+		}
+
+		if num.Sign() == 0 {
+			num.Set(rate.playback.Num())
+			denom.Set(rate.playback.Denom())
+			continue
+		}
+
+		num = lcm(num, rate.playback.Num())
+		denom.GCD(nil, nil, denom, rate.playback.Denom())
+	}
+
+	return new(big.Rat).SetFrac(num, denom)
+}
+
+// lcm returns the least common multiple of two positive integers.
+func lcm(a *big.Int, b *big.Int) *big.Int {
+	gcd := new(big.Int).GCD(nil, nil, a, b)
+	result := new(big.Int).Quo(a, gcd)
+	return result.Mul(result, b)
+}
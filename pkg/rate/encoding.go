@@ -0,0 +1,183 @@
+package rate
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// ntscCodes holds the short codes used to encode each NTSC value.
+var ntscCodes = map[NTSC]string{
+	NTSCNone:    "none",
+	NTSCNonDrop: "NDF",
+	NTSCDrop:    "DF",
+}
+
+// MarshalText implements encoding.TextMarshaler. NTSC values are encoded as a short
+// code: 'none', 'NDF' or 'DF'.
+func (ntsc NTSC) MarshalText() ([]byte, error) {
+	if err := ntsc.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(ntscCodes[ntsc]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The codes written by MarshalText
+// are accepted in any case.
+//
+// Errors are returned as a *ParseError wrapping ErrBadNtsc.
+func (ntsc *NTSC) UnmarshalText(text []byte) error {
+	for value, code := range ntscCodes {
+		if strings.EqualFold(string(text), code) {
+			*ntsc = value
+			return nil
+		}
+	}
+
+	return &ParseError{
+		Input:  string(text),
+		Format: formatNTSC,
+		Offset: -1,
+		Reason: "must be 'none', 'NDF' or 'DF'",
+		Err:    ErrBadNtsc,
+	}
+}
+
+// scanCodes holds the short codes used to encode each ScanType value.
+var scanCodes = map[ScanType]string{
+	ScanUnspecified:     "unspecified",
+	ScanProgressive:     "progressive",
+	ScanInterlacedUpper: "tff",
+	ScanInterlacedLower: "bff",
+	ScanPsF:             "psf",
+}
+
+// MarshalText implements encoding.TextMarshaler. ScanType values are encoded as a short
+// code: 'unspecified', 'progressive', 'tff', 'bff' or 'psf'.
+func (scan ScanType) MarshalText() ([]byte, error) {
+	if err := scan.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(scanCodes[scan]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The codes written by MarshalText
+// are accepted in any case.
+//
+// Errors are returned as a *ParseError wrapping ErrBadScanType.
+func (scan *ScanType) UnmarshalText(text []byte) error {
+	for value, code := range scanCodes {
+		if strings.EqualFold(string(text), code) {
+			*scan = value
+			return nil
+		}
+	}
+
+	return &ParseError{
+		Input:  string(text),
+		Format: formatScan,
+		Offset: -1,
+		Reason: "must be 'unspecified', 'progressive', 'tff', 'bff' or 'psf'",
+		Err:    ErrBadScanType,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler. The Framerate is encoded losslessly,
+// with its playback as a rational, like '24000/1001 NTSC NDF' or '24 fps'. Framerates
+// with a ScanType include the scan suffix written by String, and interlaced framerates
+// are encoded by their field rate, like '60000/1001i TFF NTSC NDF'.
+//
+// The zero value is encoded as an empty string.
+func (rate Framerate) MarshalText() ([]byte, error) {
+	if rate.playback == nil {
+		return []byte{}, nil
+	}
+	return []byte(
+		rate.displayValue().RatString() + rate.scan.notation() + " " + rate.ntsc.String(),
+	), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Values take the same form as
+// ParseDisplay, but the number is decoded as an exact rational or decimal with
+// FromRatString, so rates below 1 fps, like '1/2 fps', are not inverted. An empty string
+// decodes to the zero value.
+//
+// Errors are returned as a *ParseError.
+func (rate *Framerate) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*rate = Framerate{}
+		return nil
+	}
+
+	framerate, err := parseDisplay(string(text), fromRatString)
+	if err != nil {
+		return err
+	}
+
+	*rate = framerate
+	return nil
+}
+
+// jsonFramerate is the JSON representation of a Framerate.
+type jsonFramerate struct {
+	Playback string   `json:"playback"`
+	NTSC     NTSC     `json:"ntsc"`
+	Scan     ScanType `json:"scan,omitempty"`
+}
+
+// jsonNull is the JSON encoding of a nil value.
+var jsonNull = []byte("null")
+
+// MarshalJSON implements json.Marshaler. The Framerate is encoded losslessly as an
+// object with its playback as a rational: {"playback":"24000/1001","ntsc":"NDF"}.
+//
+// A "scan" field is added for Framerate values with a ScanType. The playback is always
+// the frame rate, even for interlaced framerates. The zero value is encoded as null.
+func (rate Framerate) MarshalJSON() ([]byte, error) {
+	if rate.playback == nil {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(jsonFramerate{
+		Playback: rate.playback.RatString(),
+		NTSC:     rate.ntsc,
+		Scan:     rate.scan,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The playback value is decoded exactly by
+// FromRatString, so it is never inverted. null leaves the Framerate unchanged.
+//
+// Errors decoding the playback or scan are returned as a *ParseError.
+func (rate *Framerate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+
+	decoded := jsonFramerate{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	framerate, err := FromRatString(decoded.Playback, decoded.NTSC)
+	if err != nil {
+		return err
+	}
+
+	if framerate, err = framerate.WithScanType(decoded.Scan); err != nil {
+		return err
+	}
+
+	*rate = framerate
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the same lossless form as MarshalText.
+func (rate Framerate) GobEncode() ([]byte, error) {
+	return rate.MarshalText()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (rate *Framerate) GobDecode(data []byte) error {
+	return rate.UnmarshalText(data)
+}
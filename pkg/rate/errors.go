@@ -29,6 +29,10 @@ var (
 		ErrParseFramerate,
 	)
 
+	// ErrNoStandardRate is returned by FromFloatSnapped when a value is not close enough
+	// to any registered Standard rate. It is wrapped by a *SnapError.
+	ErrNoStandardRate = fmt.Errorf("%w: value is not near a standard rate", ErrParseFramerate)
+
 	// ErrNegative is returned when a negative value is passed to a Framerate parser.
 	// Negative framerates are not supported.
 	ErrNegative = fmt.Errorf("%w: Framerate cannot be negative", ErrParseFramerate)
@@ -36,4 +40,21 @@ var (
 	// ErrBadNtsc is returned when an enum value outside the predefined NTSC constant values
 	// is passed into a Framerate parser.
 	ErrBadNtsc = fmt.Errorf("%w: NTSC value not recognized", ErrParseFramerate)
+
+	// ErrBadScanType is returned when an enum value outside the predefined ScanType
+	// constant values is passed into a Framerate method, or a scan suffix is not
+	// recognized.
+	ErrBadScanType = fmt.Errorf("%w: ScanType value not recognized", ErrParseFramerate)
+
+	// ErrBadStandard is returned by Register when a Standard is missing its Name or
+	// Rate.
+	ErrBadStandard = errors.New("Standard must have a Name and a Rate")
+
+	// ErrDuplicateStandard is returned by Register when the Name or one of the Aliases of
+	// a Standard is already registered.
+	ErrDuplicateStandard = errors.New("Standard name is already registered")
+
+	// ErrScanType is returned when a database value of an unsupported type is scanned
+	// into a Framerate.
+	ErrScanType = errors.New("unsupported Scan source type for Framerate")
 )
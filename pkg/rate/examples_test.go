@@ -177,3 +177,102 @@ func ExampleFromString_int() {
 	// Output:
 	// RESULT: 23.98 NTSC NDF
 }
+
+// ParseDisplay parses the output of Framerate.String.
+func ExampleParseDisplay() {
+	framerate, err := rate.ParseDisplay("29.97 NTSC DF")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("RESULT:", framerate.Playback(), framerate.NTSC() == rate.NTSCDrop)
+
+	// Output:
+	// RESULT: 30000/1001 true
+}
+
+// Parse infers the NTSC value from the string.
+func ExampleParse() {
+	for _, value := range []string{"24", "23.976", "29.97DF", "59.94i"} {
+		framerate, err := rate.Parse(value)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(value, "->", framerate)
+	}
+
+	// Output:
+	// 24 -> 24 fps
+	// 23.976 -> 23.98 NTSC NDF
+	// 29.97DF -> 29.97 NTSC DF
+	// 59.94i -> 59.94i TFF NTSC NDF
+}
+
+// Lookup finds standard rates by their name or a common alias.
+func ExampleLookup() {
+	for _, name := range []string{"pal", "23.976", "2997df"} {
+		standard, ok := rate.Lookup(name)
+		if !ok {
+			panic("rate not found: " + name)
+		}
+
+		fmt.Printf("%v -> %v (%v)\n", name, standard.Rate, standard.Label)
+	}
+
+	// Output:
+	// pal -> 25 fps (25 fps (PAL))
+	// 23.976 -> 23.98 NTSC NDF (23.976 fps)
+	// 2997df -> 29.97 NTSC DF (29.97 fps DF)
+}
+
+// FromFloatSnapped turns imprecise metadata floats into exact rates.
+func ExampleFromFloatSnapped() {
+	for _, value := range []float64{25.0, 23.976023, 59.940060} {
+		framerate, err := rate.FromFloatSnapped(value, rate.DefaultSnapTolerance)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(value, "->", framerate.Playback())
+	}
+
+	// Output:
+	// 25 -> 25/1
+	// 23.976023 -> 24000/1001
+	// 59.94006 -> 60000/1001
+}
+
+// Interlaced framerates are written by their field rate, but count timecode frames.
+func ExampleFramerate_FieldRate() {
+	framerate, err := rate.Parse("59.94i BFF")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(framerate)
+	fmt.Println("fields:", framerate.FieldRate())
+	fmt.Println("frames:", framerate.Playback())
+
+	// Output:
+	// 59.94i BFF NTSC NDF
+	// fields: 60000/1001
+	// frames: 30000/1001
+}
+
+// CommonTimebase finds a tick rate where every frame of a mixed-rate timeline is a whole
+// number of ticks.
+func ExampleCommonTimebase() {
+	timebase := rate.CommonTimebase(rate.F23_98, rate.F25)
+	fmt.Println("timebase:", timebase)
+
+	for _, framerate := range []rate.Framerate{rate.F23_98, rate.F25} {
+		ticks := new(big.Rat).Quo(timebase, framerate.Playback())
+		fmt.Println(framerate, "frame:", ticks.RatString(), "ticks")
+	}
+
+	// Output:
+	// timebase: 24000/1
+	// 23.98 NTSC NDF frame: 1001 ticks
+	// 25 fps frame: 960 ticks
+}
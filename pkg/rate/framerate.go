@@ -12,22 +12,87 @@ import (
 type Framerate struct {
 	playback *big.Rat
 	ntsc     NTSC
+	scan     ScanType
 }
 
-// String implements fmt.Stringer.
+// String implements fmt.Stringer. The result can be parsed back with ParseDisplay.
+//
+// NTSC framerates are rounded to 2 places, like '23.98 NTSC NDF'. Non-NTSC framerates
+// that are not whole numbers are shown as an exact decimal when they have one, with at
+// least 2 places, like '23.50 fps' or '23.976 fps'. Non-NTSC framerates with no exact
+// decimal are shown as a rational, like '100/3 fps', since a rounded decimal could not
+// be parsed back to the same value.
+//
+// Framerates with a ScanType are shown with a scan suffix. Interlaced framerates are
+// shown by their field rate, like '59.94i TFF NTSC NDF' for 29.97 NTSC NDF.
 func (rate Framerate) String() string {
-	rateFloat, _ := rate.playback.Float64()
+	value := rate.displayValue()
+	rateFloat, _ := value.Float64()
 	var floatString string
 	// If this playback is an int, we don't need to to show any places after the 0, and can just truncate the
 	// float.
-	if rate.playback.IsInt() {
+	if value.IsInt() {
 		floatString = fmt.Sprintf("%.0f", rateFloat)
+	} else if !rate.ntsc.IsNTSC() {
+		// Non-NTSC values must parse back exactly, so show every place of the decimal, or
+		// the rational if the decimal does not terminate.
+		if places, ok := decimalPlaces(value); ok {
+			if places < 2 {
+				places = 2
+			}
+			floatString = value.FloatString(places)
+		} else {
+			floatString = value.String()
+		}
 	} else {
 		// Otherwise round it to 2 places.
 		floatString = fmt.Sprintf("%.2f", rateFloat)
 	}
 
-	return fmt.Sprintf("%v %v", floatString, rate.ntsc)
+	return fmt.Sprintf("%v%v %v", floatString, rate.scan.notation(), rate.ntsc)
+}
+
+// decimalPlaces returns the number of decimal places needed to write value exactly. ok is
+// false if value has no exact decimal, which is the case when its denominator has a
+// prime factor other than 2 or 5.
+func decimalPlaces(value *big.Rat) (places int, ok bool) {
+	denom := new(big.Int).Set(value.Denom())
+	twos := removeFactor(denom, 2)
+	fives := removeFactor(denom, 5)
+
+	if !denom.IsInt64() || denom.Int64() != 1 {
+		return 0, false
+	}
+	if twos > fives {
+		return twos, true
+	}
+	return fives, true
+}
+
+// removeFactor divides value by factor for as long as it divides evenly, and returns the
+// number of times it did.
+func removeFactor(value *big.Int, factor int64) int {
+	divisor := big.NewInt(factor)
+	quotient, remainder := new(big.Int), new(big.Int)
+
+	count := 0
+	for {
+		quotient.QuoRem(value, divisor, remainder)
+		if remainder.Sign() != 0 {
+			return count
+		}
+		value.Set(quotient)
+		count++
+	}
+}
+
+// displayValue returns the number shown by String: the field rate for interlaced
+// framerates, and the playback otherwise.
+func (rate Framerate) displayValue() *big.Rat {
+	if rate.scan.IsInterlaced() {
+		return rate.FieldRate()
+	}
+	return rate.playback
 }
 
 // NTSC returns if and which type of NTSC standard this framerate adheres to.
@@ -71,6 +136,9 @@ var (
 	// F24 is a 24 fps, Non-NTSC framerate.
 	F24 = mustNew(24, NTSCNone)
 
+	// F25 is a 25 fps, Non-NTSC framerate, used by PAL and SECAM video.
+	F25 = mustNew(25, NTSCNone)
+
 	// F29_97Ndf is a 29.97 NTSC, Non-Drop framerate.
 	F29_97Ndf = mustNew(30, NTSCNonDrop)
 
@@ -80,12 +148,15 @@ var (
 	// F30 is a 30 fps, Non-NTSC framerate.
 	F30 = mustNew(30, NTSCNone)
 
-	// F47_95 is a 57.95 NTSC, Non-Drop framerate.
+	// F47_95 is a 47.95 NTSC, Non-Drop framerate.
 	F47_95 = mustNew(48, NTSCNonDrop)
 
 	// F48 is a 48 fps, Non-NTSC framerate.
 	F48 = mustNew(48, NTSCNone)
 
+	// F50 is a 50 fps, Non-NTSC framerate.
+	F50 = mustNew(50, NTSCNone)
+
 	// F59_94Ndf is a 59.94 NTSC, Non-Drop framerate.
 	F59_94Ndf = mustNew(60, NTSCNonDrop)
 
@@ -94,6 +165,15 @@ var (
 
 	// F60 is a 60 fps, Non-NTSC framerate.
 	F60 = mustNew(60, NTSCNone)
+
+	// F100 is a 100 fps, Non-NTSC framerate.
+	F100 = mustNew(100, NTSCNone)
+
+	// F119_88 is a 119.88 NTSC, Non-Drop framerate.
+	F119_88 = mustNew(120, NTSCNonDrop)
+
+	// F120 is a 120 fps, Non-NTSC framerate.
+	F120 = mustNew(120, NTSCNone)
 )
 
 // revive:enable
@@ -1,9 +1,14 @@
 package rate_test
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/opencinemac/vtc-go/pkg/rate"
 	"github.com/stretchr/testify/assert"
+	"math"
 	"math/big"
 	"testing"
 )
@@ -561,6 +566,323 @@ func TestFromString(t *testing.T) {
 	}
 }
 
+func TestParseDisplay(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    string
+		Expected ExpectedFramerate
+	}{
+		{
+			Name:  "23.98 NTSC NDF",
+			Input: "23.98 NTSC NDF",
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNonDrop,
+				Playback: big.NewRat(24000, 1001),
+				Timebase: big.NewRat(24, 1),
+			},
+		},
+		{
+			Name:  "29.97 NTSC DF",
+			Input: "29.97 NTSC DF",
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCDrop,
+				Playback: big.NewRat(30000, 1001),
+				Timebase: big.NewRat(30, 1),
+			},
+		},
+		{
+			Name:  "24 fps",
+			Input: "24 fps",
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNone,
+				Playback: big.NewRat(24, 1),
+				Timebase: big.NewRat(24, 1),
+			},
+		},
+		{
+			Name:  "Rational fps",
+			Input: "47/2 fps",
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNone,
+				Playback: big.NewRat(47, 2),
+				Timebase: big.NewRat(47, 2),
+			},
+		},
+		{
+			Name:  "Decimal fps",
+			Input: "23.50 fps",
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNone,
+				Playback: big.NewRat(47, 2),
+				Timebase: big.NewRat(47, 2),
+			},
+		},
+		{
+			Name:  "Bad Drop Frame",
+			Input: "23.98 NTSC DF",
+			Expected: ExpectedFramerate{
+				Err: rate.ErrBadDropFrameRate,
+			},
+		},
+		{
+			Name:  "No Suffix",
+			Input: "24",
+			Expected: ExpectedFramerate{
+				Err: rate.ErrParseFramerate,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			framerate, err := rate.ParseDisplay(tc.Input)
+			checkParse(t, framerate, err, tc.Expected)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    []string
+		Expected ExpectedFramerate
+	}{
+		{
+			Name:  "24 fps",
+			Input: []string{"24", "24/1", "1/24", "24.0", "24p", "48i", "24 P"},
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNone,
+				Playback: big.NewRat(24, 1),
+				Timebase: big.NewRat(24, 1),
+			},
+		},
+		{
+			Name:  "23.98 NTSC Non-Drop",
+			Input: []string{"23.976", "23.98", "24000/1001", "1001/24000", "23.98p", "23.976NDF"},
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNonDrop,
+				Playback: big.NewRat(24000, 1001),
+				Timebase: big.NewRat(24, 1),
+			},
+		},
+		{
+			Name:  "29.97 NTSC Non-Drop",
+			Input: []string{"29.97", "30000/1001", "59.94i", " 29.97 NDF "},
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNonDrop,
+				Playback: big.NewRat(30000, 1001),
+				Timebase: big.NewRat(30, 1),
+			},
+		},
+		{
+			Name:  "29.97 NTSC Drop",
+			Input: []string{"29.97DF", "29.97 DF", "30000/1001df", "30DF"},
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCDrop,
+				Playback: big.NewRat(30000, 1001),
+				Timebase: big.NewRat(30, 1),
+			},
+		},
+		{
+			Name:  "25 fps",
+			Input: []string{"25", "50i", "25p"},
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNone,
+				Playback: big.NewRat(25, 1),
+				Timebase: big.NewRat(25, 1),
+			},
+		},
+		{
+			Name:  "Rational fps",
+			Input: []string{"47/2", "2/47"},
+			Expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNone,
+				Playback: big.NewRat(47, 2),
+				Timebase: big.NewRat(47, 2),
+			},
+		},
+		{
+			Name:  "Imprecise",
+			Input: []string{"23.5", "24.1"},
+			Expected: ExpectedFramerate{
+				Err: rate.ErrImprecise,
+			},
+		},
+		{
+			Name:  "Bad Drop Frame",
+			Input: []string{"23.98DF", "24DF"},
+			Expected: ExpectedFramerate{
+				Err: rate.ErrBadDropFrameRate,
+			},
+		},
+		{
+			Name:  "Not Recognized",
+			Input: []string{"Not a Framerate", "24fps", "-24", "29.97 DF NDF"},
+			Expected: ExpectedFramerate{
+				Err: rate.ErrParseFramerate,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			for _, source := range tc.Input {
+				t.Run(fmt.Sprint(source), func(t *testing.T) {
+					framerate, err := rate.Parse(source)
+					checkParse(t, framerate, err, tc.Expected)
+				})
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Parse  func(value string) (rate.Framerate, error)
+		Input  string
+		Format string
+		Field  string
+		Offset int
+		Err    error
+	}{
+		{
+			Name:   "FromString Not Recognized",
+			Parse:  func(value string) (rate.Framerate, error) { return rate.FromString(value, rate.NTSCNone) },
+			Input:  "abc",
+			Format: "integer",
+			Field:  "value",
+			Offset: 0,
+			Err:    rate.ErrParseFramerate,
+		},
+		{
+			Name:   "FromString Imprecise",
+			Parse:  func(value string) (rate.Framerate, error) { return rate.FromString(value, rate.NTSCNone) },
+			Input:  "23.5",
+			Format: "float",
+			Field:  "value",
+			Offset: 0,
+			Err:    rate.ErrImprecise,
+		},
+		{
+			Name:   "Parse Not Recognized",
+			Parse:  rate.Parse,
+			Input:  "24 fps",
+			Offset: -1,
+			Err:    rate.ErrParseFramerate,
+		},
+		{
+			Name:   "Parse Imprecise",
+			Parse:  rate.Parse,
+			Input:  "  23.5p",
+			Format: "float",
+			Field:  "value",
+			Offset: 2,
+			Err:    rate.ErrImprecise,
+		},
+		{
+			Name:   "Parse Drop Frame",
+			Parse:  rate.Parse,
+			Input:  " 24000/1001 DF",
+			Format: "rational",
+			Field:  "suffix",
+			Offset: 12,
+			Err:    rate.ErrBadDropFrameRate,
+		},
+		{
+			Name:   "Parse Drop Frame After Scan",
+			Parse:  rate.Parse,
+			Input:  "47.95i DF",
+			Format: "float",
+			Field:  "suffix",
+			Offset: 7,
+			Err:    rate.ErrBadDropFrameRate,
+		},
+		{
+			Name:   "ParseDisplay Scan Suffix",
+			Parse:  rate.ParseDisplay,
+			Input:  "59.94x NTSC NDF",
+			Format: "display",
+			Field:  "suffix",
+			Offset: 5,
+			Err:    rate.ErrBadScanType,
+		},
+		{
+			Name:   "ParseDisplay Suffix",
+			Parse:  rate.ParseDisplay,
+			Input:  "24 frames",
+			Format: "display",
+			Field:  "suffix",
+			Offset: -1,
+			Err:    rate.ErrParseFramerate,
+		},
+		{
+			Name:   "ParseDisplay Drop Frame",
+			Parse:  rate.ParseDisplay,
+			Input:  "23.98 NTSC DF",
+			Format: "display",
+			Field:  "value",
+			Offset: 0,
+			Err:    rate.ErrBadDropFrameRate,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			_, err := tc.Parse(tc.Input)
+			assert.ErrorIs(err, tc.Err, "sentinel")
+			assert.ErrorIs(err, rate.ErrParseFramerate, "is framerate parse error")
+
+			var parseErr *rate.ParseError
+			if !assert.ErrorAs(err, &parseErr, "is ParseError") {
+				t.FailNow()
+			}
+
+			assert.Equal(tc.Input, parseErr.Input, "input")
+			assert.Equal(tc.Format, parseErr.Format, "format")
+			assert.Equal(tc.Field, parseErr.Field, "field")
+			assert.Equal(tc.Offset, parseErr.Offset, "offset")
+		})
+	}
+}
+
+// TestParseDisplay_RoundTrip tests that ParseDisplay can parse the output of
+// Framerate.String.
+func TestParseDisplay_RoundTrip(t *testing.T) {
+	rates := []rate.Framerate{
+		rate.F23_98,
+		rate.F24,
+		rate.F29_97Ndf,
+		rate.F29_97Df,
+		rate.F30,
+		rate.F47_95,
+		rate.F48,
+		rate.F59_94Ndf,
+		rate.F59_94Df,
+		rate.F60,
+		mustRat(47, 2, rate.NTSCNone),
+		mustRat(2997, 125, rate.NTSCNone),
+		mustRat(100, 3, rate.NTSCNone),
+		mustScan(mustRat(25, 4, rate.NTSCNone), rate.ScanInterlacedUpper),
+	}
+
+	for _, framerate := range rates {
+		t.Run(framerate.String(), func(t *testing.T) {
+			assert := assert.New(t)
+
+			parsed, err := rate.ParseDisplay(framerate.String())
+			if !assert.NoError(err, "parse display") {
+				t.FailNow()
+			}
+
+			assert.Equal(framerate.NTSC(), parsed.NTSC(), "ntsc")
+			assert.Equal(framerate.Playback(), parsed.Playback(), "playback")
+		})
+	}
+}
+
 func TestConstRates(t *testing.T) {
 	cases := []struct {
 		Name     string
@@ -667,6 +989,56 @@ func TestConstRates(t *testing.T) {
 				Err:      nil,
 			},
 		},
+		{
+			Name:     "F25",
+			constant: rate.F25,
+			expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNone,
+				Playback: big.NewRat(25, 1),
+				Timebase: big.NewRat(25, 1),
+				Err:      nil,
+			},
+		},
+		{
+			Name:     "F50",
+			constant: rate.F50,
+			expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNone,
+				Playback: big.NewRat(50, 1),
+				Timebase: big.NewRat(50, 1),
+				Err:      nil,
+			},
+		},
+		{
+			Name:     "F100",
+			constant: rate.F100,
+			expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNone,
+				Playback: big.NewRat(100, 1),
+				Timebase: big.NewRat(100, 1),
+				Err:      nil,
+			},
+		},
+		{
+			Name:     "F119_88",
+			constant: rate.F119_88,
+			expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNonDrop,
+				Playback: big.NewRat(120000, 1001),
+				Timebase: big.NewRat(120, 1),
+				Err:      nil,
+			},
+		},
+		{
+			Name:     "F120",
+			constant: rate.F120,
+			expected: ExpectedFramerate{
+				Ntsc:     rate.NTSCNone,
+				Playback: big.NewRat(120, 1),
+				Timebase: big.NewRat(120, 1),
+				Err:      nil,
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -768,6 +1140,31 @@ func TestFramerate_String(t *testing.T) {
 			Rate:     rate.F60,
 			Expected: "60 fps",
 		},
+		{
+			Name:     "",
+			Rate:     mustRat(47, 2, rate.NTSCNone),
+			Expected: "23.50 fps",
+		},
+		{
+			Name:     "",
+			Rate:     mustRat(25, 2, rate.NTSCNone),
+			Expected: "12.50 fps",
+		},
+		{
+			Name:     "",
+			Rate:     mustRat(2997, 125, rate.NTSCNone),
+			Expected: "23.976 fps",
+		},
+		{
+			Name:     "",
+			Rate:     mustRat(100, 3, rate.NTSCNone),
+			Expected: "100/3 fps",
+		},
+		{
+			Name:     "",
+			Rate:     mustScan(mustRat(25, 4, rate.NTSCNone), rate.ScanInterlacedUpper),
+			Expected: "12.50i TFF fps",
+		},
 	}
 
 	for _, tc := range cases {
@@ -781,3 +1178,649 @@ func TestFramerate_String(t *testing.T) {
 func TestNTSC_String_Invalid(t *testing.T) {
 	assert.Equal(t, "[INVALID NTSC VALUE]", rate.NTSC(100).String())
 }
+
+// mustRat creates a Framerate from a rational value, and panics on an error.
+func mustRat(num int64, denom int64, ntsc rate.NTSC) rate.Framerate {
+	framerate, err := rate.FromRat(big.NewRat(num, denom), ntsc)
+	if err != nil {
+		panic(fmt.Errorf("error making framerate: %w", err))
+	}
+	return framerate
+}
+
+func TestFramerate_Encoding(t *testing.T) {
+	cases := []struct {
+		Rate rate.Framerate
+		Text string
+		JSON string
+	}{
+		{
+			Rate: rate.F23_98,
+			Text: "24000/1001 NTSC NDF",
+			JSON: `{"playback":"24000/1001","ntsc":"NDF"}`,
+		},
+		{
+			Rate: rate.F29_97Df,
+			Text: "30000/1001 NTSC DF",
+			JSON: `{"playback":"30000/1001","ntsc":"DF"}`,
+		},
+		{
+			Rate: rate.F24,
+			Text: "24 fps",
+			JSON: `{"playback":"24","ntsc":"none"}`,
+		},
+		{
+			Rate: mustRat(47, 2, rate.NTSCNone),
+			Text: "47/2 fps",
+			JSON: `{"playback":"47/2","ntsc":"none"}`,
+		},
+		{
+			Rate: mustRat(1, 2, rate.NTSCNone),
+			Text: "1/2 fps",
+			JSON: `{"playback":"1/2","ntsc":"none"}`,
+		},
+		{
+			Rate: mustScan(mustRat(1, 4, rate.NTSCNone), rate.ScanInterlacedUpper),
+			Text: "1/2i TFF fps",
+			JSON: `{"playback":"1/4","ntsc":"none","scan":"tff"}`,
+		},
+		{
+			Rate: mustScan(rate.F29_97Df, rate.ScanInterlacedLower),
+			Text: "60000/1001i BFF NTSC DF",
+			JSON: `{"playback":"30000/1001","ntsc":"DF","scan":"bff"}`,
+		},
+		{
+			Rate: mustScan(rate.F23_98, rate.ScanPsF),
+			Text: "24000/1001PsF NTSC NDF",
+			JSON: `{"playback":"24000/1001","ntsc":"NDF","scan":"psf"}`,
+		},
+		{
+			Rate: mustScan(rate.F25, rate.ScanProgressive),
+			Text: "25p fps",
+			JSON: `{"playback":"25","ntsc":"none","scan":"progressive"}`,
+		},
+		{
+			Rate: rate.Framerate{},
+			Text: "",
+			JSON: "null",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Text, func(t *testing.T) {
+			text, err := testCase.Rate.MarshalText()
+			assert.NoError(t, err, "marshal text")
+			assert.Equal(t, testCase.Text, string(text), "text")
+
+			decoded := rate.Framerate{}
+			assert.NoError(t, decoded.UnmarshalText(text), "unmarshal text")
+			assert.Equal(t, testCase.Rate, decoded, "decoded text")
+
+			data, err := json.Marshal(testCase.Rate)
+			assert.NoError(t, err, "marshal json")
+			assert.Equal(t, testCase.JSON, string(data), "json")
+
+			decoded = rate.Framerate{}
+			assert.NoError(t, json.Unmarshal(data, &decoded), "unmarshal json")
+			assert.Equal(t, testCase.Rate, decoded, "decoded json")
+
+			buffer := new(bytes.Buffer)
+			assert.NoError(t, gob.NewEncoder(buffer).Encode(testCase.Rate), "encode gob")
+
+			decoded = rate.Framerate{}
+			assert.NoError(t, gob.NewDecoder(buffer).Decode(&decoded), "decode gob")
+			assert.Equal(t, testCase.Rate, decoded, "decoded gob")
+		})
+	}
+}
+
+func TestFramerate_Encoding_Errors(t *testing.T) {
+	cases := []struct {
+		Name string
+		Data string
+		Err  error
+	}{
+		{Name: "Bad NTSC", Data: `{"playback":"24","ntsc":"PAL"}`, Err: rate.ErrBadNtsc},
+		{Name: "Bad Drop Frame", Data: `{"playback":"24","ntsc":"DF"}`, Err: rate.ErrBadDropFrameRate},
+		{Name: "Bad Playback", Data: `{"playback":"fast","ntsc":"none"}`, Err: rate.ErrParseFramerate},
+		{Name: "Bad Scan", Data: `{"playback":"24","ntsc":"none","scan":"i"}`, Err: rate.ErrBadScanType},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := json.Unmarshal([]byte(testCase.Data), new(rate.Framerate))
+			assert.True(t, errors.Is(err, testCase.Err), "error is %v: %v", testCase.Err, err)
+
+			var parseErr *rate.ParseError
+			assert.True(t, errors.As(err, &parseErr), "is ParseError")
+		})
+	}
+}
+
+func TestFramerate_UnmarshalJSON_BadScanValue(t *testing.T) {
+	for _, data := range []string{
+		`{"playback":"24","ntsc":"none","scan":"sideways"}`,
+		`{"playback":"24","ntsc":"none","scan":9}`,
+	} {
+		t.Run(data, func(t *testing.T) {
+			framerate := rate.F24
+			err := json.Unmarshal([]byte(data), &framerate)
+			assert.Error(t, err, "unmarshal")
+			assert.Equal(t, rate.F24, framerate, "unchanged")
+		})
+	}
+}
+
+func TestNTSC_Text(t *testing.T) {
+	for _, ntsc := range []rate.NTSC{rate.NTSCNone, rate.NTSCNonDrop, rate.NTSCDrop} {
+		t.Run(ntsc.String(), func(t *testing.T) {
+			text, err := ntsc.MarshalText()
+			assert.NoError(t, err, "marshal")
+
+			var decoded rate.NTSC
+			assert.NoError(t, decoded.UnmarshalText(bytes.ToLower(text)), "unmarshal")
+			assert.Equal(t, ntsc, decoded)
+		})
+	}
+
+	_, err := rate.NTSC(5).MarshalText()
+	assert.ErrorIs(t, err, rate.ErrBadNtsc)
+}
+
+func TestFramerate_SQL(t *testing.T) {
+	value, err := rate.F29_97Df.Value()
+	assert.NoError(t, err, "value")
+	assert.Equal(t, "30000/1001 NTSC DF", value)
+
+	for _, src := range []interface{}{value, []byte(value.(string))} {
+		scanned := rate.Framerate{}
+		assert.NoError(t, scanned.Scan(src), "scan %T", src)
+		assert.Equal(t, rate.F29_97Df, scanned, "scan %T", src)
+	}
+
+	err = new(rate.Framerate).Scan(int64(24))
+	assert.ErrorIs(t, err, rate.ErrScanType)
+}
+
+func TestNullFramerate(t *testing.T) {
+	value, err := rate.NullFramerate{Framerate: rate.F24, Valid: true}.Value()
+	assert.NoError(t, err, "value")
+	assert.Equal(t, "24 fps", value)
+
+	scanned := rate.NullFramerate{}
+	assert.NoError(t, scanned.Scan(value), "scan")
+	assert.Equal(t, rate.NullFramerate{Framerate: rate.F24, Valid: true}, scanned)
+
+	value, err = rate.NullFramerate{Framerate: rate.F24}.Value()
+	assert.NoError(t, err, "null value")
+	assert.Nil(t, value, "null value")
+
+	assert.NoError(t, scanned.Scan(nil), "scan null")
+	assert.Equal(t, rate.NullFramerate{}, scanned, "scan null")
+}
+
+func TestLookup(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Expected rate.Framerate
+		Label    string
+	}{
+		{Name: "23.98", Expected: rate.F23_98, Label: "23.976 fps"},
+		{Name: "23.976", Expected: rate.F23_98, Label: "23.976 fps"},
+		{Name: "film", Expected: rate.F24, Label: "24 fps"},
+		{Name: "PAL", Expected: rate.F25, Label: "25 fps (PAL)"},
+		{Name: "ntsc", Expected: rate.F29_97Ndf, Label: "29.97 fps NDF"},
+		{Name: "2997df", Expected: rate.F29_97Df, Label: "29.97 fps DF"},
+		{Name: "29.97 DF", Expected: rate.F29_97Df, Label: "29.97 fps DF"},
+		{Name: " 50 ", Expected: rate.F50, Label: "50 fps"},
+		{Name: "100", Expected: rate.F100, Label: "100 fps"},
+		{Name: "119.88", Expected: rate.F119_88, Label: "119.88 fps"},
+		{Name: "120p", Expected: rate.F120, Label: "120 fps"},
+		{Name: "47.952", Expected: rate.F47_95, Label: "47.952 fps"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			standard, ok := rate.Lookup(testCase.Name)
+			if !assert.True(t, ok, "found") {
+				t.FailNow()
+			}
+
+			assert.Equal(t, testCase.Expected, standard.Rate, "rate")
+			assert.Equal(t, testCase.Label, standard.Label, "label")
+		})
+	}
+
+	_, ok := rate.Lookup("not a rate")
+	assert.False(t, ok, "unknown name")
+}
+
+func TestAll(t *testing.T) {
+	all := rate.All()
+	if !assert.GreaterOrEqual(t, len(all), 15, "built-in count") {
+		t.FailNow()
+	}
+
+	assert.Equal(t, "23.98", all[0].Name, "first")
+	assert.Equal(t, "120", all[14].Name, "last built-in")
+
+	// Built-in rates are sorted by speed.
+	for i := 1; i < 15; i++ {
+		assert.True(
+			t,
+			all[i-1].Rate.Playback().Cmp(all[i].Rate.Playback()) <= 0,
+			"%v before %v", all[i-1].Name, all[i].Name,
+		)
+	}
+
+	// Changing the returned values must not change the registry.
+	all[0].Aliases[0] = "changed"
+	_, ok := rate.Lookup("23.976")
+	assert.True(t, ok, "registry not changed")
+	assert.Equal(t, "23.976", rate.All()[0].Aliases[0], "aliases not changed")
+}
+
+func TestRegister(t *testing.T) {
+	custom, err := rate.FromInt(36, rate.NTSCNone)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	err = rate.Register(rate.Standard{
+		Name:    "Shutter Test",
+		Aliases: []string{"st36"},
+		Label:   "Shutter Test (36 fps)",
+		Rate:    custom,
+	})
+	if !assert.NoError(t, err, "register") {
+		t.FailNow()
+	}
+
+	standard, ok := rate.Lookup("shuttertest")
+	if assert.True(t, ok, "lookup name") {
+		assert.Equal(t, custom, standard.Rate)
+		assert.Equal(t, "Shutter Test", standard.Name)
+	}
+
+	_, ok = rate.Lookup("ST36")
+	assert.True(t, ok, "lookup alias")
+
+	all := rate.All()
+	assert.Equal(t, "Shutter Test", all[len(all)-1].Name, "registered last")
+}
+
+func TestRegister_Errors(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Standard rate.Standard
+		Err      error
+	}{
+		{
+			Name:     "no name",
+			Standard: rate.Standard{Name: "  ", Rate: rate.F24},
+			Err:      rate.ErrBadStandard,
+		},
+		{
+			Name:     "no rate",
+			Standard: rate.Standard{Name: "no rate"},
+			Err:      rate.ErrBadStandard,
+		},
+		{
+			Name:     "duplicate name",
+			Standard: rate.Standard{Name: "23.98", Rate: rate.F23_98},
+			Err:      rate.ErrDuplicateStandard,
+		},
+		{
+			Name:     "duplicate alias",
+			Standard: rate.Standard{Name: "my pal", Aliases: []string{"PAL"}, Rate: rate.F25},
+			Err:      rate.ErrDuplicateStandard,
+		},
+		{
+			Name:     "duplicate own alias",
+			Standard: rate.Standard{Name: "dupe", Aliases: []string{"DUPE"}, Rate: rate.F25},
+			Err:      rate.ErrDuplicateStandard,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			count := len(rate.All())
+			err := rate.Register(testCase.Standard)
+			assert.ErrorIs(t, err, testCase.Err)
+			assert.Len(t, rate.All(), count, "nothing registered")
+		})
+	}
+
+	_, ok := rate.Lookup("my pal")
+	assert.False(t, ok, "partial registration")
+}
+
+func TestFromFloatSnapped(t *testing.T) {
+	cases := []struct {
+		Value     float64
+		Tolerance float64
+		Expected  rate.Framerate
+	}{
+		{Value: 25.0, Tolerance: rate.DefaultSnapTolerance, Expected: rate.F25},
+		{Value: 24, Tolerance: 0, Expected: rate.F24},
+		{Value: 23.976023, Tolerance: rate.DefaultSnapTolerance, Expected: rate.F23_98},
+		{Value: 23.976, Tolerance: rate.DefaultSnapTolerance, Expected: rate.F23_98},
+		{Value: 23.98, Tolerance: rate.DefaultSnapTolerance, Expected: rate.F23_98},
+		{Value: 29.97, Tolerance: rate.DefaultSnapTolerance, Expected: rate.F29_97Ndf},
+		{Value: 47.952047, Tolerance: rate.DefaultSnapTolerance, Expected: rate.F47_95},
+		{Value: 50.0, Tolerance: rate.DefaultSnapTolerance, Expected: rate.F50},
+		{Value: 59.940060, Tolerance: rate.DefaultSnapTolerance, Expected: rate.F59_94Ndf},
+		{Value: 60.000001, Tolerance: rate.DefaultSnapTolerance, Expected: rate.F60},
+		{Value: 119.880119, Tolerance: rate.DefaultSnapTolerance, Expected: rate.F119_88},
+		{Value: 119.7, Tolerance: 0.5, Expected: rate.F119_88},
+	}
+
+	for _, testCase := range cases {
+		t.Run(fmt.Sprint(testCase.Value), func(t *testing.T) {
+			framerate, err := rate.FromFloatSnapped(testCase.Value, testCase.Tolerance)
+			if assert.NoError(t, err) {
+				assert.Equal(t, testCase.Expected, framerate)
+			}
+		})
+	}
+}
+
+func TestFromFloatSnapped_Errors(t *testing.T) {
+	_, err := rate.FromFloatSnapped(26.5, rate.DefaultSnapTolerance)
+	assert.ErrorIs(t, err, rate.ErrNoStandardRate)
+	assert.ErrorIs(t, err, rate.ErrParseFramerate)
+
+	var snapErr *rate.SnapError
+	if assert.ErrorAs(t, err, &snapErr) {
+		assert.Equal(t, 26.5, snapErr.Value, "value")
+		assert.Equal(t, rate.DefaultSnapTolerance, snapErr.Tolerance, "tolerance")
+
+		names := make([]string, len(snapErr.Candidates))
+		for i, candidate := range snapErr.Candidates {
+			names[i] = candidate.Name
+		}
+		assert.Equal(t, []string{"25", "24", "23.98"}, names, "candidates")
+	}
+	assert.EqualError(
+		t,
+		err,
+		"could not parse Framerate: value is not near a standard rate: 26.5 is not within "+
+			"0.01 of a standard rate, nearest are 25 (1.5 away), 24 (2.5 away), 23.98 (2.524 away)",
+	)
+
+	_, err = rate.FromFloatSnapped(23.9, rate.DefaultSnapTolerance)
+	assert.ErrorIs(t, err, rate.ErrNoStandardRate, "between rates")
+
+	_, err = rate.FromFloatSnapped(-24, rate.DefaultSnapTolerance)
+	assert.ErrorIs(t, err, rate.ErrNegative, "negative")
+
+	_, err = rate.FromFloatSnapped(math.NaN(), rate.DefaultSnapTolerance)
+	assert.ErrorIs(t, err, rate.ErrImprecise, "NaN")
+
+	_, err = rate.FromFloatSnapped(math.Inf(1), rate.DefaultSnapTolerance)
+	assert.ErrorIs(t, err, rate.ErrImprecise, "Inf")
+}
+
+// mustScan returns framerate with its ScanType set to scan, and panics on an error.
+func mustScan(framerate rate.Framerate, scan rate.ScanType) rate.Framerate {
+	framerate, err := framerate.WithScanType(scan)
+	if err != nil {
+		panic(fmt.Errorf("error setting scan type: %w", err))
+	}
+	return framerate
+}
+
+func TestParse_ScanType(t *testing.T) {
+	cases := []struct {
+		Input     string
+		Scan      rate.ScanType
+		Playback  *big.Rat
+		FieldRate *big.Rat
+		String    string
+	}{
+		{
+			Input:     "24",
+			Scan:      rate.ScanUnspecified,
+			Playback:  big.NewRat(24, 1),
+			FieldRate: big.NewRat(24, 1),
+			String:    "24 fps",
+		},
+		{
+			Input:     "29.97p",
+			Scan:      rate.ScanProgressive,
+			Playback:  big.NewRat(30000, 1001),
+			FieldRate: big.NewRat(30000, 1001),
+			String:    "29.97p NTSC NDF",
+		},
+		{
+			Input:     "59.94i",
+			Scan:      rate.ScanInterlacedUpper,
+			Playback:  big.NewRat(30000, 1001),
+			FieldRate: big.NewRat(60000, 1001),
+			String:    "59.94i TFF NTSC NDF",
+		},
+		{
+			Input:     "59.94i BFF DF",
+			Scan:      rate.ScanInterlacedLower,
+			Playback:  big.NewRat(30000, 1001),
+			FieldRate: big.NewRat(60000, 1001),
+			String:    "59.94i BFF NTSC DF",
+		},
+		{
+			Input:     "50itff",
+			Scan:      rate.ScanInterlacedUpper,
+			Playback:  big.NewRat(25, 1),
+			FieldRate: big.NewRat(50, 1),
+			String:    "50i TFF fps",
+		},
+		{
+			Input:     "23.98PsF",
+			Scan:      rate.ScanPsF,
+			Playback:  big.NewRat(24000, 1001),
+			FieldRate: big.NewRat(48000, 1001),
+			String:    "23.98PsF NTSC NDF",
+		},
+		{
+			Input:     "25 psf",
+			Scan:      rate.ScanPsF,
+			Playback:  big.NewRat(25, 1),
+			FieldRate: big.NewRat(50, 1),
+			String:    "25PsF fps",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Input, func(t *testing.T) {
+			assert := assert.New(t)
+
+			framerate, err := rate.Parse(testCase.Input)
+			if !assert.NoError(err, "parse") {
+				t.FailNow()
+			}
+
+			assert.Equal(testCase.Scan, framerate.ScanType(), "scan type")
+			assert.Equal(testCase.Playback, framerate.Playback(), "playback")
+			assert.Equal(testCase.FieldRate, framerate.FieldRate(), "field rate")
+			assert.Equal(testCase.String, framerate.String(), "string")
+
+			parsed, err := rate.ParseDisplay(framerate.String())
+			if assert.NoError(err, "parse display") {
+				assert.Equal(framerate, parsed, "display round trip")
+			}
+		})
+	}
+}
+
+func TestParse_ScanType_Errors(t *testing.T) {
+	for _, input := range []string{"24tff", "24p TFF", "24 i i", "24 DF i"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := rate.Parse(input)
+			assert.ErrorIs(t, err, rate.ErrParseFramerate)
+		})
+	}
+}
+
+func TestFramerate_FieldsPerFrame(t *testing.T) {
+	cases := []struct {
+		Scan           rate.ScanType
+		FieldsPerFrame int64
+		IsInterlaced   bool
+	}{
+		{Scan: rate.ScanUnspecified, FieldsPerFrame: 1, IsInterlaced: false},
+		{Scan: rate.ScanProgressive, FieldsPerFrame: 1, IsInterlaced: false},
+		{Scan: rate.ScanInterlacedUpper, FieldsPerFrame: 2, IsInterlaced: true},
+		{Scan: rate.ScanInterlacedLower, FieldsPerFrame: 2, IsInterlaced: true},
+		{Scan: rate.ScanPsF, FieldsPerFrame: 2, IsInterlaced: false},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Scan.String(), func(t *testing.T) {
+			framerate := mustScan(rate.F24, testCase.Scan)
+			assert.Equal(t, testCase.FieldsPerFrame, framerate.FieldsPerFrame(), "fields per frame")
+			assert.Equal(t, testCase.IsInterlaced, testCase.Scan.IsInterlaced(), "is interlaced")
+			assert.Equal(t, testCase.FieldsPerFrame == 2, testCase.Scan.HasFields(), "has fields")
+			assert.Equal(t, big.NewRat(24, 1), framerate.Playback(), "playback")
+		})
+	}
+}
+
+func TestFramerate_WithScanType_Invalid(t *testing.T) {
+	_, err := rate.F24.WithScanType(rate.ScanType(9))
+	assert.ErrorIs(t, err, rate.ErrBadScanType)
+	assert.Equal(t, "[INVALID SCAN TYPE]", rate.ScanType(9).String())
+}
+
+func TestScanType_Text(t *testing.T) {
+	scans := []rate.ScanType{
+		rate.ScanUnspecified,
+		rate.ScanProgressive,
+		rate.ScanInterlacedUpper,
+		rate.ScanInterlacedLower,
+		rate.ScanPsF,
+	}
+
+	for _, scan := range scans {
+		t.Run(scan.String(), func(t *testing.T) {
+			text, err := scan.MarshalText()
+			assert.NoError(t, err, "marshal")
+
+			var decoded rate.ScanType
+			assert.NoError(t, decoded.UnmarshalText(bytes.ToUpper(text)), "unmarshal")
+			assert.Equal(t, scan, decoded)
+		})
+	}
+
+	_, err := rate.ScanType(-1).MarshalText()
+	assert.ErrorIs(t, err, rate.ErrBadScanType)
+}
+
+func TestFramerate_Equal(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Rate     rate.Framerate
+		Other    rate.Framerate
+		Equal    bool
+		Expected int
+	}{
+		{Name: "Same", Rate: rate.F23_98, Other: mustRat(24000, 1001, rate.NTSCNonDrop), Equal: true, Expected: 0},
+		{Name: "Slower", Rate: rate.F23_98, Other: rate.F24, Equal: false, Expected: -1},
+		{Name: "Faster", Rate: rate.F60, Other: rate.F59_94Ndf, Equal: false, Expected: 1},
+		{Name: "Drop Frame", Rate: rate.F29_97Ndf, Other: rate.F29_97Df, Equal: false, Expected: 0},
+		{
+			Name:     "Scan Type",
+			Rate:     rate.F29_97Ndf,
+			Other:    mustScan(rate.F29_97Ndf, rate.ScanInterlacedUpper),
+			Equal:    false,
+			Expected: 0,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert.Equal(t, testCase.Equal, testCase.Rate.Equal(testCase.Other), "equal")
+			assert.Equal(t, testCase.Equal, testCase.Other.Equal(testCase.Rate), "equal reversed")
+			assert.Equal(t, testCase.Expected, testCase.Rate.Cmp(testCase.Other), "cmp")
+			assert.Equal(t, -testCase.Expected, testCase.Other.Cmp(testCase.Rate), "cmp reversed")
+		})
+	}
+
+	assert.True(t, rate.Framerate{}.Equal(rate.Framerate{}), "zero values")
+	assert.False(t, rate.Framerate{}.Equal(rate.F24), "zero value and rate")
+
+	assert.Equal(t, 0, rate.Framerate{}.Cmp(rate.Framerate{}), "cmp zero values")
+	assert.Equal(t, -1, rate.Framerate{}.Cmp(rate.F24), "cmp zero value and rate")
+	assert.Equal(t, 1, rate.F24.Cmp(rate.Framerate{}), "cmp rate and zero value")
+}
+
+func TestFramerate_FramesPerFrame(t *testing.T) {
+	cases := []struct {
+		Rate     rate.Framerate
+		Other    rate.Framerate
+		Expected *big.Rat
+	}{
+		{Rate: rate.F48, Other: rate.F24, Expected: big.NewRat(2, 1)},
+		{Rate: rate.F24, Other: rate.F48, Expected: big.NewRat(1, 2)},
+		{Rate: rate.F24, Other: rate.F23_98, Expected: big.NewRat(1001, 1000)},
+		{Rate: rate.F25, Other: rate.F24, Expected: big.NewRat(25, 24)},
+		{Rate: rate.F59_94Df, Other: rate.F29_97Ndf, Expected: big.NewRat(2, 1)},
+	}
+
+	for _, testCase := range cases {
+		t.Run(fmt.Sprintf("%v per %v", testCase.Rate, testCase.Other), func(t *testing.T) {
+			assert.Equal(t, testCase.Expected, testCase.Rate.FramesPerFrame(testCase.Other))
+		})
+	}
+}
+
+func TestCommonTimebase(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Rates    []rate.Framerate
+		Expected *big.Rat
+	}{
+		{Name: "Single", Rates: []rate.Framerate{rate.F23_98}, Expected: big.NewRat(24000, 1001)},
+		{Name: "23.98 and 25", Rates: []rate.Framerate{rate.F23_98, rate.F25}, Expected: big.NewRat(24000, 1)},
+		{Name: "24 and 48", Rates: []rate.Framerate{rate.F24, rate.F48}, Expected: big.NewRat(48, 1)},
+		{
+			Name:     "NTSC",
+			Rates:    []rate.Framerate{rate.F23_98, rate.F29_97Df, rate.F59_94Ndf},
+			Expected: big.NewRat(120000, 1001),
+		},
+		{
+			Name:     "Rational",
+			Rates:    []rate.Framerate{mustRat(47, 2, rate.NTSCNone), rate.F24},
+			Expected: big.NewRat(1128, 1),
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			timebase := rate.CommonTimebase(testCase.Rates...)
+			assert.Equal(testCase.Expected, timebase, "common timebase")
+
+			for _, framerate := range testCase.Rates {
+				ticks := new(big.Rat).Quo(timebase, framerate.Playback())
+				assert.True(ticks.IsInt(), "%v frame is %v ticks", framerate, ticks)
+			}
+		})
+	}
+}
+
+func TestCommonTimebase_Panics(t *testing.T) {
+	assert.Panics(t, func() { rate.CommonTimebase() }, "no rates")
+	assert.Panics(t, func() { rate.CommonTimebase(rate.F24, mustRat(0, 1, rate.NTSCNone)) }, "zero rate")
+	assert.PanicsWithValue(
+		t,
+		"CommonTimebase cannot use the zero value Framerate",
+		func() { rate.CommonTimebase(rate.F24, rate.Framerate{}) },
+		"zero value",
+	)
+}
+
+func TestFramerate_FramesPerFrame_Panics(t *testing.T) {
+	const message = "FramesPerFrame cannot use the zero value Framerate"
+	assert.PanicsWithValue(t, message, func() { rate.Framerate{}.FramesPerFrame(rate.F24) }, "zero rate")
+	assert.PanicsWithValue(t, message, func() { rate.F24.FramesPerFrame(rate.Framerate{}) }, "zero other")
+}
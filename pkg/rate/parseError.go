@@ -0,0 +1,88 @@
+package rate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError is returned by the string parsers in this package. It records the input
+// that could not be parsed and what was wrong with it, and wraps one of the package's
+// sentinel errors so it can still be checked with errors.Is.
+type ParseError struct {
+	// Input is the string that could not be parsed.
+	Input string
+	// Format is the form Input was being parsed as: "integer", "float", "rational",
+	// "display", "ntsc" or "scan". It is empty if no form could be detected.
+	Format string
+	// Field is the name of the part of Input at fault: "value" for the number, or
+	// "suffix" for an NTSC or scan suffix. It is empty when the error is not specific to
+	// a single part.
+	Field string
+	// Offset is the byte offset of Field in Input, or -1 if the error is not specific to
+	// a position in Input.
+	Offset int
+	// Reason is a human-readable explanation of what was wrong with Input.
+	Reason string
+	// Err is the sentinel error being wrapped, like ErrBadDropFrameRate.
+	Err error
+}
+
+// Error implements error.
+//
+// Ex: could not parse Framerate: non-ntsc framerates cannot be parsed from floats due to
+// imprecision (float "23.5", byte 0)
+func (err *ParseError) Error() string {
+	builder := strings.Builder{}
+	builder.WriteString(err.Err.Error())
+	if err.Reason != "" {
+		builder.WriteString(": ")
+		builder.WriteString(err.Reason)
+	}
+
+	format := "input"
+	if err.Format != "" {
+		format = err.Format
+	}
+	builder.WriteString(fmt.Sprintf(" (%v %q", format, err.Input))
+
+	if err.Offset >= 0 {
+		builder.WriteString(fmt.Sprintf(", byte %d", err.Offset))
+	}
+	builder.WriteString(")")
+
+	return builder.String()
+}
+
+// Unwrap returns Err, so ParseError values can be checked against this package's
+// sentinel errors with errors.Is.
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
+
+// Values for ParseError.Format.
+const (
+	formatInteger  = "integer"
+	formatFloat    = "float"
+	formatRational = "rational"
+	formatDisplay  = "display"
+	formatNTSC     = "ntsc"
+	formatScan     = "scan"
+)
+
+// Values for ParseError.Field.
+const (
+	fieldValue  = "value"
+	fieldSuffix = "suffix"
+)
+
+// valueFormat returns the ParseError.Format for a number string.
+func valueFormat(value string) string {
+	switch {
+	case strings.Contains(value, "/"):
+		return formatRational
+	case strings.Contains(value, "."):
+		return formatFloat
+	default:
+		return formatInteger
+	}
+}
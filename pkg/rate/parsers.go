@@ -1,10 +1,14 @@
 package rate
 
 import (
+	"errors"
 	"fmt"
 	"github.com/opencinemac/vtc-go/pkg/internal"
 	"math/big"
+	"regexp"
 	"strconv"
+	"strings"
+	"unicode"
 )
 
 // FromRat creates a Framerate from a *big.Rat value.
@@ -83,7 +87,24 @@ func FromInt(value int64, ntsc NTSC) (Framerate, error) {
 //
 // It's common for some metadata reporting tools to represent the Timebase as '1/24' instead of '24/1'. If the
 // numerator of a parsed rational is found to be smaller than the denominator, the rational will be inverted.
+//
+// Errors are returned as a *ParseError.
 func FromString(value string, ntsc NTSC) (Framerate, error) {
+	framerate, err := fromString(value, ntsc)
+	if err != nil {
+		return Framerate{}, &ParseError{
+			Input:  value,
+			Format: valueFormat(value),
+			Field:  fieldValue,
+			Offset: 0,
+			Err:    err,
+		}
+	}
+	return framerate, nil
+}
+
+// fromString implements FromString without wrapping errors in a *ParseError.
+func fromString(value string, ntsc NTSC) (Framerate, error) {
 	intVal, err := strconv.ParseInt(value, 10, 64)
 	if err == nil {
 		return FromInt(intVal, ntsc)
@@ -110,6 +131,288 @@ func FromString(value string, ntsc NTSC) (Framerate, error) {
 	return FromRat(ratValue, ntsc)
 }
 
+// FromRatString creates a new Framerate from an exact rational or decimal string, like
+// '24000/1001', '1/2' or '23.5', and passes it to FromRat.
+//
+// Unlike FromString, rationals are never inverted, so this is the function to use when
+// decoding a playback value that was written with big.Rat.RatString, like the one stored
+// by Framerate.MarshalJSON. Rates below 1 fps, like '1/2', are decoded as-is.
+//
+// Errors are returned as a *ParseError.
+func FromRatString(value string, ntsc NTSC) (Framerate, error) {
+	framerate, err := fromRatString(value, ntsc)
+	if err != nil {
+		return Framerate{}, &ParseError{
+			Input:  value,
+			Format: valueFormat(value),
+			Field:  fieldValue,
+			Offset: 0,
+			Err:    err,
+		}
+	}
+	return framerate, nil
+}
+
+// fromRatString implements FromRatString without wrapping errors in a *ParseError.
+func fromRatString(value string, ntsc NTSC) (Framerate, error) {
+	ratValue, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return Framerate{}, fmt.Errorf(
+			"%w: string format not recognized. must be int, decimal, or rational", ErrParseFramerate,
+		)
+	}
+	return FromRat(ratValue, ntsc)
+}
+
+// parseRegex is the regex we are going to use to parse framerates with Parse.
+var parseRegex = regexp.MustCompile(
+	`^(?P<value>[0-9]+(\.[0-9]+)?(/[0-9]+)?)\s*` +
+		`(?P<scan>(?i:psf|p|i(\s*(?:tff|bff))?))?\s*` +
+		`(?P<ntsc>(?i:ndf|df))?$`,
+)
+
+// Indexes of our submatch groups.
+const (
+	parseRegexValue = 1
+	parseRegexScan  = 4
+	parseRegexNTSC  = 6
+)
+
+// ntscTolerance is how far a non-integer value may be from the nearest NTSC rate,
+// relative to that rate, for Parse to treat it as NTSC. It is half of the 1000/1001
+// NTSC slowdown, so '23.98' and '23.976' are NTSC, while '23.5' is not.
+var ntscTolerance = big.NewRat(1, 2000)
+
+// Parse creates a new Framerate from a string, inferring the NTSC value from the input
+// rather than having it passed in.
+//
+// The value may be an integer, float, or rational, optionally followed by a suffix:
+//
+// • Whole numbers are NTSCNone: '24', '24/1'.
+//
+// • Floats close to an NTSC rate, and rationals over 1001, are NTSCNonDrop: '23.976',
+// '29.97', '30000/1001'.
+//
+// • 'DF' makes the rate NTSCDrop: '29.97DF', '59.94 DF'.
+//
+// • 'NDF' does not change the inferred value: '29.97NDF'.
+//
+// The value may also be followed by a scan suffix, which sets the ScanType, and comes
+// before any NTSC suffix:
+//
+// • 'p' is ScanProgressive, and 'PsF' is ScanPsF: '24p', '23.98PsF'.
+//
+// • 'i' marks the value as an interlaced field rate, which is halved to get the
+// framerate: '59.94i' is 29.97 NTSC NDF, '50i' is 25 fps. It may be followed by a field
+// order of 'TFF' or 'BFF', and is ScanInterlacedUpper if none is given: '59.94i BFF'.
+//
+// Floats that are not close to an NTSC rate return ErrImprecise, since there is no
+// way to know what exact value they are meant to be.
+//
+// Errors are returned as a *ParseError.
+func Parse(value string) (Framerate, error) {
+	trimmed := strings.TrimLeftFunc(value, unicode.IsSpace)
+	leading := len(value) - len(trimmed)
+	trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+
+	loc := parseRegex.FindStringSubmatchIndex(trimmed)
+	if loc == nil {
+		return Framerate{}, &ParseError{
+			Input:  value,
+			Offset: -1,
+			Reason: "must be int, float, or rational, with optional i, p or PsF, and DF or NDF suffixes",
+			Err:    ErrParseFramerate,
+		}
+	}
+
+	valueStr := trimmed[loc[2*parseRegexValue]:loc[2*parseRegexValue+1]]
+	scan := ScanUnspecified
+	if start := loc[2*parseRegexScan]; start >= 0 {
+		// The regex has already validated the suffix, so this will not fail.
+		scan, _ = parseScanNotation(trimmed[start:loc[2*parseRegexScan+1]])
+	}
+	ntscSuffix := ""
+	if start := loc[2*parseRegexNTSC]; start >= 0 {
+		ntscSuffix = strings.ToLower(trimmed[start:loc[2*parseRegexNTSC+1]])
+	}
+
+	framerate, err := parseMatch(valueStr, scan, ntscSuffix)
+	if err != nil {
+		parseErr := &ParseError{
+			Input:  value,
+			Format: valueFormat(valueStr),
+			Field:  fieldValue,
+			Offset: leading + loc[2*parseRegexValue],
+			Err:    err,
+		}
+		// Drop-frame errors are caused by asking for drop-frame on a rate that can't
+		// have it.
+		if errors.Is(err, ErrBadDropFrameRate) && ntscSuffix == "df" {
+			parseErr.Field = fieldSuffix
+			parseErr.Offset = leading + loc[2*parseRegexNTSC]
+		}
+		return Framerate{}, parseErr
+	}
+
+	return framerate, nil
+}
+
+// parseMatch implements Parse for the value, scan type and lower-case NTSC suffix
+// matched by parseRegex.
+func parseMatch(valueStr string, scan ScanType, ntscSuffix string) (Framerate, error) {
+	// The regex has already validated the value, so this will not fail.
+	playback, _ := new(big.Rat).SetString(valueStr)
+	// Some programs print 1/24 instead of 24/1.
+	if playback.Sign() != 0 && playback.Num().Cmp(playback.Denom()) == -1 {
+		playback.Inv(playback)
+	}
+
+	if scan.IsInterlaced() {
+		playback.Mul(playback, big.NewRat(1, fieldsPerFrame))
+	}
+
+	ntsc := NTSCNone
+	if !playback.IsInt() {
+		switch {
+		case playback.Denom().Int64() == 1001 || isNearNTSC(playback):
+			ntsc = NTSCNonDrop
+		case strings.Contains(valueStr, "."):
+			return Framerate{}, ErrImprecise
+		}
+	}
+
+	if ntscSuffix == "df" {
+		ntsc = NTSCDrop
+	}
+
+	framerate, err := FromRat(playback, ntsc)
+	if err != nil {
+		return Framerate{}, err
+	}
+	framerate.scan = scan
+	return framerate, nil
+}
+
+// isNearNTSC returns whether value is within ntscTolerance of the nearest NTSC rate.
+func isNearNTSC(value *big.Rat) bool {
+	// Go from the NTSC playback rate to the timebase so we can round it.
+	timebase := new(big.Rat).Mul(value, big.NewRat(1001, 1000))
+	timebase = internal.RoundRat(timebase)
+	if timebase.Sign() == 0 {
+		return false
+	}
+
+	ntscValue := timebase.Mul(timebase, big.NewRat(1000, 1001))
+
+	// Get the absolute difference relative to the NTSC value.
+	diff := new(big.Rat).Sub(value, ntscValue)
+	diff.Abs(diff)
+	diff.Quo(diff, ntscValue)
+
+	return diff.Cmp(ntscTolerance) == -1
+}
+
+// ParseDisplay parses a Framerate from the form returned by Framerate.String, like
+// '23.98 NTSC NDF', '29.97 NTSC DF', '24 fps', '23.50 fps', '100/3 fps' or
+// '59.94i TFF NTSC NDF'.
+//
+// The suffix sets the NTSC value of the Framerate, and the number is then parsed by
+// FromString, except that decimal 'fps' values are taken to be exact, as String writes
+// them. The number may be followed by a scan suffix of 'p', 'PsF', 'i TFF' or 'i BFF',
+// in which case an interlaced number is a field rate, as with Parse.
+//
+// Errors are returned as a *ParseError.
+func ParseDisplay(value string) (Framerate, error) {
+	return parseDisplay(value, fromDisplayString)
+}
+
+// fromDisplayString parses the number of a ParseDisplay value. Non-NTSC decimals are
+// parsed exactly by fromRatString rather than being rejected as imprecise, and all other
+// values are parsed by fromString.
+func fromDisplayString(value string, ntsc NTSC) (Framerate, error) {
+	if !ntsc.IsNTSC() && valueFormat(value) == formatFloat {
+		return fromRatString(value, ntsc)
+	}
+	return fromString(value, ntsc)
+}
+
+// parseDisplay implements ParseDisplay, using parseValue to parse the number before the
+// scan suffix.
+func parseDisplay(
+	value string, parseValue func(value string, ntsc NTSC) (Framerate, error),
+) (Framerate, error) {
+	// The suffixes are the String() values of each NTSC constant.
+	for _, ntsc := range []NTSC{NTSCNone, NTSCNonDrop, NTSCDrop} {
+		suffix := " " + ntsc.String()
+		if !strings.HasSuffix(value, suffix) {
+			continue
+		}
+
+		number := strings.TrimSuffix(value, suffix)
+		scan := ScanUnspecified
+		if index := strings.IndexFunc(number, unicode.IsLetter); index != -1 {
+			var ok bool
+			if scan, ok = parseScanNotation(number[index:]); !ok {
+				return Framerate{}, &ParseError{
+					Input:  value,
+					Format: formatDisplay,
+					Field:  fieldSuffix,
+					Offset: index,
+					Reason: "scan suffix must be 'p', 'PsF', 'i TFF' or 'i BFF'",
+					Err:    ErrBadScanType,
+				}
+			}
+			number = number[:index]
+		}
+
+		framerate, err := parseDisplayNumber(number, ntsc, scan, parseValue)
+		if err != nil {
+			return Framerate{}, &ParseError{
+				Input:  value,
+				Format: formatDisplay,
+				Field:  fieldValue,
+				Offset: 0,
+				Err:    err,
+			}
+		}
+		return framerate, nil
+	}
+
+	return Framerate{}, &ParseError{
+		Input:  value,
+		Format: formatDisplay,
+		Field:  fieldSuffix,
+		Offset: -1,
+		Reason: "must end in 'fps', 'NTSC NDF' or 'NTSC DF'",
+		Err:    ErrParseFramerate,
+	}
+}
+
+// parseDisplayNumber implements ParseDisplay for the number before the scan suffix,
+// which is a field rate for interlaced values.
+func parseDisplayNumber(
+	number string,
+	ntsc NTSC,
+	scan ScanType,
+	parseValue func(value string, ntsc NTSC) (Framerate, error),
+) (Framerate, error) {
+	framerate, err := parseValue(number, ntsc)
+	if err != nil {
+		return Framerate{}, err
+	}
+
+	if scan.IsInterlaced() {
+		playback := framerate.playback
+		framerate, err = FromRat(playback.Mul(playback, big.NewRat(1, fieldsPerFrame)), ntsc)
+		if err != nil {
+			return Framerate{}, err
+		}
+	}
+
+	framerate.scan = scan
+	return framerate, nil
+}
+
 // dropFrameDivisor is used to test whether a playback value is a valid drop-frame playback rate. Drop frame must
 // be divisible by 30000/1001 (29.97 NTSC). If the result of multiplying an incoming playback value by this value
 // is not an integer, then we should return an error
@@ -0,0 +1,183 @@
+package rate
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Standard is a named, standard Framerate, as held by the registry used by Lookup and
+// All.
+type Standard struct {
+	// Name is the canonical name of the rate, like '23.98' or '29.97df'.
+	Name string
+	// Aliases are other names Lookup will find the rate by, like 'pal' or '2997df'.
+	Aliases []string
+	// Label is a human-readable label for the rate, for use in UI elements like
+	// dropdowns: (ex: '23.976 fps').
+	Label string
+	// Rate is the Framerate.
+	Rate Framerate
+}
+
+// registry holds the registered Standard values.
+type registry struct {
+	lock sync.RWMutex
+	// standards holds the Standard values in the order they were registered.
+	standards []Standard
+	// byName maps each normalized name and alias to the index of its Standard.
+	byName map[string]int
+}
+
+// standards is the registry used by Register, Lookup and All.
+var standards = &registry{byName: map[string]int{}}
+
+// builtinStandards are the Standard values that ship with this library, in the order
+// returned by All.
+var builtinStandards = []Standard{
+	{
+		Name:    "23.98",
+		Aliases: []string{"23.976", "2398", "23976", "23.98ndf", "23.976ndf"},
+		Label:   "23.976 fps",
+		Rate:    F23_98,
+	},
+	{Name: "24", Aliases: []string{"24p", "film"}, Label: "24 fps", Rate: F24},
+	{Name: "25", Aliases: []string{"25p", "pal", "secam"}, Label: "25 fps (PAL)", Rate: F25},
+	{
+		Name:    "29.97",
+		Aliases: []string{"2997", "29.97ndf", "2997ndf", "ntsc"},
+		Label:   "29.97 fps NDF",
+		Rate:    F29_97Ndf,
+	},
+	{Name: "29.97df", Aliases: []string{"2997df"}, Label: "29.97 fps DF", Rate: F29_97Df},
+	{Name: "30", Aliases: []string{"30p"}, Label: "30 fps", Rate: F30},
+	{
+		Name:    "47.95",
+		Aliases: []string{"47.952", "4795", "47952", "47.95ndf"},
+		Label:   "47.952 fps",
+		Rate:    F47_95,
+	},
+	{Name: "48", Aliases: []string{"48p"}, Label: "48 fps", Rate: F48},
+	{Name: "50", Aliases: []string{"50p"}, Label: "50 fps", Rate: F50},
+	{
+		Name:    "59.94",
+		Aliases: []string{"5994", "59.94ndf", "5994ndf"},
+		Label:   "59.94 fps NDF",
+		Rate:    F59_94Ndf,
+	},
+	{Name: "59.94df", Aliases: []string{"5994df"}, Label: "59.94 fps DF", Rate: F59_94Df},
+	{Name: "60", Aliases: []string{"60p"}, Label: "60 fps", Rate: F60},
+	{Name: "100", Aliases: []string{"100p"}, Label: "100 fps", Rate: F100},
+	{
+		Name:    "119.88",
+		Aliases: []string{"11988", "119.88ndf"},
+		Label:   "119.88 fps",
+		Rate:    F119_88,
+	},
+	{Name: "120", Aliases: []string{"120p"}, Label: "120 fps", Rate: F120},
+}
+
+func init() {
+	for _, standard := range builtinStandards {
+		if err := Register(standard); err != nil {
+			panic(fmt.Errorf("error registering built-in rate %v: %w", standard.Name, err))
+		}
+	}
+}
+
+// normalizeName returns the form of a name used as a registry key. Names are not case
+// or whitespace sensitive, so '29.97 DF' and '29.97df' are the same name.
+func normalizeName(name string) string {
+	return strings.Map(func(char rune) rune {
+		if unicode.IsSpace(char) {
+			return -1
+		}
+		return unicode.ToLower(char)
+	}, name)
+}
+
+/*
+Register adds a Standard to the registry, so it can be found by Lookup and is returned by
+All. It is meant for adding house-specific rates from an init function:
+
+	func init() {
+		err := rate.Register(rate.Standard{
+			Name:    "shutter-test",
+			Aliases: []string{"st"},
+			Label:   "Shutter Test (36 fps)",
+			Rate:    shutterTestRate,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+
+Names are not case or whitespace sensitive. ErrBadStandard is returned if standard has no
+Name or Rate, and ErrDuplicateStandard if its Name or any of its Aliases are already
+registered. Nothing is registered if an error is returned.
+
+Register is safe for concurrent use.
+*/
+func Register(standard Standard) error {
+	if normalizeName(standard.Name) == "" || standard.Rate.playback == nil {
+		return fmt.Errorf("%w: %q", ErrBadStandard, standard.Name)
+	}
+
+	// Copy the aliases so they cannot be changed out from under us by the caller.
+	standard.Aliases = append([]string(nil), standard.Aliases...)
+
+	standards.lock.Lock()
+	defer standards.lock.Unlock()
+
+	names := append([]string{standard.Name}, standard.Aliases...)
+	keys := make(map[string]bool, len(names))
+	for _, name := range names {
+		key := normalizeName(name)
+		if _, ok := standards.byName[key]; ok || keys[key] {
+			return fmt.Errorf("%w: %q", ErrDuplicateStandard, name)
+		}
+		keys[key] = true
+	}
+
+	index := len(standards.standards)
+	standards.standards = append(standards.standards, standard)
+	for key := range keys {
+		standards.byName[key] = index
+	}
+
+	return nil
+}
+
+// Lookup returns the registered Standard with a Name or Alias of name, like '23.976',
+// 'pal' or '29.97 DF'. Names are not case or whitespace sensitive. ok is false if no
+// Standard has the name.
+func Lookup(name string) (standard Standard, ok bool) {
+	standards.lock.RLock()
+	defer standards.lock.RUnlock()
+
+	index, ok := standards.byName[normalizeName(name)]
+	if !ok {
+		return Standard{}, false
+	}
+	return standards.standards[index].copy(), true
+}
+
+// All returns every registered Standard: first the built-in standards in order of
+// speed, then any added by Register in the order they were registered.
+func All() []Standard {
+	standards.lock.RLock()
+	defer standards.lock.RUnlock()
+
+	all := make([]Standard, len(standards.standards))
+	for i, standard := range standards.standards {
+		all[i] = standard.copy()
+	}
+	return all
+}
+
+// copy returns a copy of the Standard with its own Aliases slice.
+func (standard Standard) copy() Standard {
+	standard.Aliases = append([]string(nil), standard.Aliases...)
+	return standard
+}
@@ -0,0 +1,153 @@
+package rate
+
+import (
+	"math/big"
+	"strings"
+)
+
+// ScanType is an enum-like type for specifying how the frames of a framerate are
+// scanned: as whole frames, or as pairs of interlaced fields.
+type ScanType int
+
+const (
+	// ScanUnspecified means the scan type of the framerate is not known. It is the
+	// zero value, and is treated as progressive.
+	ScanUnspecified ScanType = iota
+	// ScanProgressive means each frame is captured and displayed whole: '29.97p'.
+	ScanProgressive
+	// ScanInterlacedUpper means each frame is two interlaced fields, captured at
+	// different times, with the upper field first (TFF): '59.94i'.
+	ScanInterlacedUpper
+	// ScanInterlacedLower means each frame is two interlaced fields, captured at
+	// different times, with the lower field first (BFF), as in NTSC DV: '59.94i BFF'.
+	ScanInterlacedLower
+	// ScanPsF means each progressive frame is split into two fields for transport, with
+	// both fields captured at the same time: '23.98PsF'.
+	ScanPsF
+)
+
+// String implements fmt.Stringer.
+func (scan ScanType) String() string {
+	switch scan {
+	case ScanUnspecified:
+		return "unspecified"
+	case ScanProgressive:
+		return "progressive"
+	case ScanInterlacedUpper:
+		return "interlaced TFF"
+	case ScanInterlacedLower:
+		return "interlaced BFF"
+	case ScanPsF:
+		return "PsF"
+	default:
+		return "[INVALID SCAN TYPE]"
+	}
+}
+
+// IsInterlaced returns whether this value is ScanInterlacedUpper or
+// ScanInterlacedLower.
+func (scan ScanType) IsInterlaced() bool {
+	return scan == ScanInterlacedUpper || scan == ScanInterlacedLower
+}
+
+// HasFields returns whether each frame is split into two fields. Returns true for
+// interlaced values and ScanPsF.
+func (scan ScanType) HasFields() bool {
+	return scan.IsInterlaced() || scan == ScanPsF
+}
+
+// Validate returns ErrBadScanType if this value is not one of the pre-defined ScanType
+// enum constants that ships with this library.
+func (scan ScanType) Validate() error {
+	if scan < ScanUnspecified || scan > ScanPsF {
+		return ErrBadScanType
+	}
+	return nil
+}
+
+// notation returns the suffix used for this value by Framerate.String, like 'i TFF'.
+func (scan ScanType) notation() string {
+	switch scan {
+	case ScanProgressive:
+		return "p"
+	case ScanInterlacedUpper:
+		return "i TFF"
+	case ScanInterlacedLower:
+		return "i BFF"
+	case ScanPsF:
+		return "PsF"
+	default:
+		return ""
+	}
+}
+
+// fieldsPerFrame is the number of fields in a frame of a ScanType that HasFields.
+const fieldsPerFrame = 2
+
+// ScanType returns how the frames of this framerate are scanned.
+func (rate Framerate) ScanType() ScanType {
+	return rate.scan
+}
+
+// WithScanType returns a copy of the Framerate with its ScanType set to scan. The
+// playback speed is not changed, so F29_97Ndf with ScanInterlacedUpper is 59.94i.
+//
+// ErrBadScanType is returned if scan is not a valid ScanType.
+func (rate Framerate) WithScanType(scan ScanType) (Framerate, error) {
+	if err := scan.Validate(); err != nil {
+		return Framerate{}, err
+	}
+	rate.scan = scan
+	return rate, nil
+}
+
+// FieldsPerFrame returns 2 if the frames of this framerate are split into fields, and 1
+// otherwise.
+func (rate Framerate) FieldsPerFrame() int64 {
+	if rate.scan.HasFields() {
+		return fieldsPerFrame
+	}
+	return 1
+}
+
+/*
+FieldRate returns the rate at which fields are played back, in fields-per-second. For
+framerates without fields, this is the same as Playback.
+
+What it is
+
+Interlaced video is labeled by its field rate, so 59.94i has a FieldRate of
+60000/1001, and a Playback and timecode framerate of 30000/1001. PsF video is labeled by
+its frame rate, so 23.98PsF has a FieldRate of 48000/1001 and a Playback of 24000/1001.
+
+Where you see it
+
+• Broadcast format names, like 1080i50 or 1080i59.94.
+
+• SDI and HDMI signal rates.
+*/
+func (rate Framerate) FieldRate() *big.Rat {
+	playback := rate.Playback()
+	return playback.Mul(playback, big.NewRat(rate.FieldsPerFrame(), 1))
+}
+
+// scanNotations maps the lower-case scan suffixes accepted by Parse and ParseDisplay to
+// their ScanType. A bare 'i' is upper field first, the most common field order.
+var scanNotations = map[string]ScanType{
+	"p":     ScanProgressive,
+	"i":     ScanInterlacedUpper,
+	"i tff": ScanInterlacedUpper,
+	"i bff": ScanInterlacedLower,
+	"psf":   ScanPsF,
+}
+
+// parseScanNotation returns the ScanType of a scan suffix, in any case and with any
+// whitespace between the 'i' and the field order.
+func parseScanNotation(notation string) (ScanType, bool) {
+	normalized := strings.ToLower(strings.Join(strings.Fields(notation), " "))
+	if strings.HasPrefix(normalized, "i") && len(normalized) > 1 && normalized[1] != ' ' {
+		normalized = "i " + normalized[1:]
+	}
+	scan, ok := scanNotations[normalized]
+	return scan, ok
+}
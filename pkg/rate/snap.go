@@ -0,0 +1,115 @@
+package rate
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultSnapTolerance is a tolerance for FromFloatSnapped that accepts NTSC rates
+// written to two decimal places, like 23.98 or 47.95, while keeping every built-in
+// Standard rate distinct.
+const DefaultSnapTolerance = 0.01
+
+// snapCandidates is the number of nearest Standard rates reported by a SnapError.
+const snapCandidates = 3
+
+// SnapError is returned by FromFloatSnapped when a value is not within tolerance of
+// any registered Standard rate. It wraps ErrNoStandardRate.
+type SnapError struct {
+	// Value is the float that could not be snapped.
+	Value float64
+	// Tolerance is the furthest Value was allowed to be from a Standard rate.
+	Tolerance float64
+	// Candidates are the registered Standard rates nearest to Value, nearest first.
+	Candidates []Standard
+}
+
+// Error implements error.
+//
+// Ex: could not parse Framerate: value is not near a standard rate: 26.5 is not within
+// 0.01 of a standard rate, nearest are 25 (1.5 away), 24 (2.5 away), 23.98 (2.524 away)
+func (err *SnapError) Error() string {
+	candidates := make([]string, len(err.Candidates))
+	for i, candidate := range err.Candidates {
+		candidates[i] = fmt.Sprintf(
+			"%v (%v away)",
+			candidate.Name,
+			strconv.FormatFloat(snapDistance(err.Value, candidate), 'g', 4, 64),
+		)
+	}
+
+	return fmt.Sprintf(
+		"%v: %v is not within %v of a standard rate, nearest are %v",
+		ErrNoStandardRate,
+		formatSnapFloat(err.Value),
+		formatSnapFloat(err.Tolerance),
+		strings.Join(candidates, ", "),
+	)
+}
+
+// Unwrap returns ErrNoStandardRate.
+func (err *SnapError) Unwrap() error {
+	return ErrNoStandardRate
+}
+
+// formatSnapFloat formats value for a SnapError message.
+func formatSnapFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// snapDistance returns how far value is from the playback of standard in
+// frames-per-second.
+func snapDistance(value float64, standard Standard) float64 {
+	playback, _ := standard.Rate.playback.Float64()
+	return math.Abs(value - playback)
+}
+
+/*
+FromFloatSnapped creates a new Framerate from an imprecise float64, like the 25.0,
+23.976023 or 59.940060 reported by camera metadata, MediaInfo and spreadsheets, by
+snapping it to the nearest registered Standard rate. See All.
+
+Unlike FromFloat, this works for both NTSC and whole-number rates, and returns the exact
+rational Framerate of the matched Standard. tolerance is the furthest, in
+frames-per-second, value may be from the playback of a Standard; DefaultSnapTolerance
+works for most values.
+
+When two standard rates share a playback speed, like 29.97 NTSC NDF and DF, the first
+registered is used, which for the built-in rates is always the non-drop rate.
+
+A *SnapError wrapping ErrNoStandardRate is returned if no Standard is within tolerance,
+listing the nearest Standard rates. ErrNegative is returned for negative values, and
+ErrImprecise for values that are not a number or are infinite.
+*/
+func FromFloatSnapped(value float64, tolerance float64) (Framerate, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Framerate{}, ErrImprecise
+	}
+	if value < 0 {
+		return Framerate{}, ErrNegative
+	}
+
+	candidates := All()
+	// SliceStable keeps the first registered Standard first when two are the same
+	// distance away.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return snapDistance(value, candidates[i]) < snapDistance(value, candidates[j])
+	})
+
+	if len(candidates) > 0 && snapDistance(value, candidates[0]) <= tolerance {
+		return candidates[0].Rate, nil
+	}
+
+	if len(candidates) > snapCandidates {
+		candidates = candidates[:snapCandidates]
+	}
+
+	return Framerate{}, &SnapError{
+		Value:      value,
+		Tolerance:  tolerance,
+		Candidates: candidates,
+	}
+}
@@ -0,0 +1,59 @@
+package rate
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer. The Framerate is stored as text in the lossless form
+// written by MarshalText, like '24000/1001 NTSC NDF'.
+func (rate Framerate) Value() (driver.Value, error) {
+	text, err := rate.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner for text columns written by Value. Use NullFramerate for
+// columns that may be NULL.
+func (rate *Framerate) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case string:
+		return rate.UnmarshalText([]byte(value))
+	case []byte:
+		return rate.UnmarshalText(value)
+	default:
+		return fmt.Errorf("%w: cannot scan %T into Framerate", ErrScanType, src)
+	}
+}
+
+// NullFramerate represents a Framerate that may be NULL. It implements sql.Scanner and
+// driver.Valuer, like sql.NullString.
+type NullFramerate struct {
+	Framerate Framerate
+	// Valid is true if Framerate is not NULL.
+	Valid bool
+}
+
+// Value implements driver.Valuer.
+func (null NullFramerate) Value() (driver.Value, error) {
+	if !null.Valid {
+		return nil, nil
+	}
+	return null.Framerate.Value()
+}
+
+// Scan implements sql.Scanner.
+func (null *NullFramerate) Scan(src interface{}) error {
+	if src == nil {
+		null.Framerate, null.Valid = Framerate{}, false
+		return nil
+	}
+
+	if err := null.Framerate.Scan(src); err != nil {
+		return err
+	}
+	null.Valid = true
+	return nil
+}
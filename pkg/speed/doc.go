@@ -0,0 +1,17 @@
+/*
+Package speed models transfers that play material back at a different speed than it
+was shot, like NTSC pull-down and PAL speed-up, and how they affect picture duration and
+audio.
+
+Transfers
+
+Film shot at 24 fps is transferred to NTSC video at 23.98 fps, and to PAL video at
+25 fps, by playing every film frame as one video frame. The frame count does not change,
+but the picture runs 0.1% slower for NTSC, and 4.1667% faster for PAL.
+
+Sound recorded alongside the picture has to change speed by the same amount to stay in
+sync. It can either be played back at an adjusted sample rate, like 48 kHz audio played
+at 47.952 kHz for NTSC, which also shifts its pitch, or be resampled and pitch corrected.
+Audio that is left at its original speed drifts away from the picture over time.
+*/
+package speed
@@ -0,0 +1,10 @@
+package speed
+
+import "errors"
+
+// speed comes with sentinel errors for catching transfer problems.
+var (
+	// ErrBadTransfer is returned when a Transfer is created with a framerate that has a
+	// playback of 0, or is the zero value.
+	ErrBadTransfer = errors.New("Transfer framerates must have a positive playback")
+)
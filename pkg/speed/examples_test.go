@@ -0,0 +1,32 @@
+package speed_test
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/speed"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+)
+
+// Transfers report how picture and sound change when film is delivered at video rates.
+func ExampleTransfer() {
+	reel := tc.FromFrames(86400, rate.F24)
+
+	for _, transfer := range []speed.Transfer{speed.PullDown, speed.PALSpeedUp} {
+		sampleRate, _ := transfer.PlaybackSampleRate(tc.SampleRate48k).Float64()
+
+		fmt.Println(transfer)
+		fmt.Println("  runtime:", transfer.Convert(reel).Runtime(3))
+		fmt.Println("  drift:  ", transfer.Drift(reel).Runtime(3))
+		fmt.Printf("  audio:   %.3f Hz, %+.2f cents\n", sampleRate, transfer.PitchCents())
+	}
+
+	// Output:
+	// 24 fps -> 23.98 NTSC NDF
+	//   runtime: 01:00:03.6
+	//   drift:   00:00:03.6
+	//   audio:   47952.048 Hz, -1.73 cents
+	// 24 fps -> 25 fps
+	//   runtime: 00:57:36.0
+	//   drift:   -00:02:24.0
+	//   audio:   50000.000 Hz, +70.67 cents
+}
@@ -0,0 +1,214 @@
+package speed
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"math"
+	"math/big"
+)
+
+/*
+Transfer is a frame-for-frame speed change, where every frame shot at one framerate is
+played back as a single frame at another.
+
+What it is
+
+The number of frames does not change, so a 1000 frame shot is still 1000 frames long
+after the transfer, but its real-world duration is scaled by the inverse of the Factor.
+Use tc.Timecode.Rebase to count the same frames at the new framerate, and Convert to
+also keep any sub-frame position.
+
+Where you see it
+
+• Telecine and film scans delivered at NTSC video rates: 24 fps to 23.98, a pull-down.
+
+• Film, and 24 fps digital masters, delivered to PAL territories at 25 fps: a PAL
+speed-up.
+
+• Sound departments conforming production audio to a picture that has changed speed.
+
+The zero value is not a valid Transfer, and will cause a panic if used.
+*/
+type Transfer struct {
+	// from is the framerate the material was shot at.
+	from rate.Framerate
+	// to is the framerate the material is played back at.
+	to rate.Framerate
+}
+
+// mustNew creates a new Transfer and panics if there is an error. Used for creating our
+// Transfer constants.
+func mustNew(from rate.Framerate, to rate.Framerate) Transfer {
+	transfer, err := New(from, to)
+	if err != nil {
+		panic(fmt.Errorf("error creating Transfer from %v to %v: %w", from, to, err))
+	}
+	return transfer
+}
+
+// revive:disable
+
+// speed comes with the common film and video transfers pre-defined.
+var (
+	// PullDown plays 24 fps film at 23.98 NTSC NDF, 0.1% slower.
+	PullDown = mustNew(rate.F24, rate.F23_98)
+
+	// PullUp plays 23.98 NTSC NDF material at 24 fps, 0.1% faster. It reverses PullDown.
+	PullUp = mustNew(rate.F23_98, rate.F24)
+
+	// PALSpeedUp plays 24 fps film at 25 fps, 4.1667% faster.
+	PALSpeedUp = mustNew(rate.F24, rate.F25)
+
+	// PALSlowDown plays 25 fps material at 24 fps, 4% slower. It reverses PALSpeedUp.
+	PALSlowDown = mustNew(rate.F25, rate.F24)
+
+	// NTSCToPAL plays 23.98 NTSC NDF material at 25 fps, 4.2708% faster.
+	NTSCToPAL = mustNew(rate.F23_98, rate.F25)
+
+	// PALToNTSC plays 25 fps material at 23.98 NTSC NDF, 4.0959% slower. It reverses
+	// NTSCToPAL.
+	PALToNTSC = mustNew(rate.F25, rate.F23_98)
+)
+
+// revive:enable
+
+// New creates a Transfer where every frame shot at from is played back as one frame at
+// to.
+//
+// ErrBadTransfer is returned if from or to are the zero value, or have a playback of 0.
+func New(from rate.Framerate, to rate.Framerate) (Transfer, error) {
+	for _, framerate := range []rate.Framerate{from, to} {
+		if framerate.Equal(rate.Framerate{}) || framerate.Playback().Sign() == 0 {
+			return Transfer{}, fmt.Errorf("%w: %v to %v", ErrBadTransfer, from, to)
+		}
+	}
+
+	return Transfer{from: from, to: to}, nil
+}
+
+// From returns the framerate the material was shot at.
+func (transfer Transfer) From() rate.Framerate {
+	return transfer.from
+}
+
+// To returns the framerate the material is played back at.
+func (transfer Transfer) To() rate.Framerate {
+	return transfer.to
+}
+
+// Reverse returns the Transfer that undoes this one, so PullDown.Reverse() is PullUp.
+func (transfer Transfer) Reverse() Transfer {
+	return Transfer{from: transfer.to, to: transfer.from}
+}
+
+// String implements fmt.Stringer: (ex: 24 fps -> 23.98 NTSC NDF).
+func (transfer Transfer) String() string {
+	return fmt.Sprintf("%v -> %v", transfer.from, transfer.to)
+}
+
+// Factor returns how much faster the material plays back after the transfer. It is
+// over 1 for a speed-up, like 25/24 for PALSpeedUp, and under 1 for a slow-down, like
+// 1000/1001 for PullDown.
+func (transfer Transfer) Factor() *big.Rat {
+	return transfer.to.FramesPerFrame(transfer.from)
+}
+
+// PercentChange returns the change in speed as a percentage of the original speed. It is
+// 25/6 (4.1667%) for PALSpeedUp, and -100/1001 (-0.0999%) for PullDown.
+func (transfer Transfer) PercentChange() *big.Rat {
+	percent := transfer.Factor()
+	percent.Sub(percent, big.NewRat(1, 1))
+	return percent.Mul(percent, big.NewRat(100, 1))
+}
+
+/*
+PitchCents returns how far the pitch of audio shifts when it is played back at the new
+speed without pitch correction, in cents. There are 100 cents in a semitone.
+
+What it is
+
+Playing audio faster raises its pitch. The 4.1667% PAL speed-up raises the pitch by
+70.67 cents, almost three quarters of a semitone, which is clearly audible on music and
+voices, so PAL audio is often pitch corrected. The 0.1% NTSC pull-down lowers the pitch
+by only 1.73 cents, which is usually left alone.
+*/
+func (transfer Transfer) PitchCents() float64 {
+	factor, _ := transfer.Factor().Float64()
+	return 1200 * math.Log2(factor)
+}
+
+/*
+PlaybackSampleRate returns the rate at which audio recorded at sampleRate must be played
+back to stay in sync with the transferred picture, in samples-per-second.
+
+What it is
+
+Instead of resampling, audio can be kept in sync by changing the rate its samples are
+played at by the same Factor as the picture. For PullDown, 48 kHz audio is played at
+48000000/1001, commonly written as 47.952 kHz. The sample count does not change, so
+each frame still holds the same samples as before the transfer.
+
+Where you see it
+
+• Production recorders with 48.048 kHz and 47.952 kHz modes, which record or play audio
+at the pulled rate.
+
+• Sample rate settings when importing pulled-down audio into Pro Tools.
+
+Note: this method will panic if sampleRate is not positive.
+*/
+func (transfer Transfer) PlaybackSampleRate(sampleRate tc.SampleRate) *big.Rat {
+	if sampleRate <= 0 {
+		panic(fmt.Sprintf("sample rate %d must be positive", sampleRate))
+	}
+
+	adjusted := transfer.Factor()
+	return adjusted.Mul(adjusted, big.NewRat(int64(sampleRate), 1))
+}
+
+// Convert returns the Timecode timecode lands on after the transfer, at the To
+// framerate. timecode is the real-world time of the material when played at the From
+// framerate.
+//
+// The frame count is kept, along with any sub-frame position and the Rollover policy, so
+// converting 01:00:00:00 @ 24 fps with PullDown returns 01:00:00:00 @ 23.98 NTSC NDF,
+// which is 3.6 seconds longer.
+func (transfer Transfer) Convert(timecode tc.Timecode) tc.Timecode {
+	// Rollover24h must only be applied at the new framerate, once the value is scaled.
+	rollover := timecode.Rollover()
+	scaled := timecode.WithRollover(tc.RolloverNone).Mul(new(big.Rat).Inv(transfer.Factor()))
+	return atRate(scaled, transfer.to).WithRollover(rollover)
+}
+
+/*
+Drift returns how far audio that is not conformed falls out of sync with the transferred
+picture by the time timecode has elapsed, at the To framerate.
+
+What it is
+
+Audio left at its original speed keeps its original duration, while the picture gets
+longer or shorter. The drift grows with time: after an hour, PullDown leaves the audio
+3.6 seconds, or 86.3 frames, short of the picture, and PALSpeedUp leaves it 2.4 minutes
+long.
+
+The result is positive when the picture runs longer than the audio, as with a
+slow-down, and negative when it runs shorter. Like Convert, timecode is the real-world
+time of the material when played at the From framerate.
+
+Where you see it
+
+• Sync problems where production audio plays back at 48 kHz against pulled-down
+picture, which slip by a frame roughly every 42 seconds.
+*/
+func (transfer Transfer) Drift(timecode tc.Timecode) tc.Timecode {
+	// The drift is a duration, so must not be wrapped by Rollover24h.
+	timecode = timecode.WithRollover(tc.RolloverNone)
+	return transfer.Convert(timecode).Sub(atRate(timecode, transfer.to))
+}
+
+// atRate returns timecode at framerate, keeping its exact real-world seconds rather than
+// rounding them to the nearest whole frame. The Rollover policy is not kept.
+func atRate(timecode tc.Timecode, framerate rate.Framerate) tc.Timecode {
+	return tc.FromSecondsExact(timecode.Seconds(), framerate)
+}
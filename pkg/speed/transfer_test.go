@@ -0,0 +1,194 @@
+package speed_test
+
+import (
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/speed"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestTransfer(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Transfer      speed.Transfer
+		Factor        *big.Rat
+		PercentChange *big.Rat
+		PitchCents    float64
+		SampleRate    *big.Rat
+		String        string
+	}{
+		{
+			Name:          "PullDown",
+			Transfer:      speed.PullDown,
+			Factor:        big.NewRat(1000, 1001),
+			PercentChange: big.NewRat(-100, 1001),
+			PitchCents:    -1.7304,
+			SampleRate:    big.NewRat(48000000, 1001),
+			String:        "24 fps -> 23.98 NTSC NDF",
+		},
+		{
+			Name:          "PullUp",
+			Transfer:      speed.PullUp,
+			Factor:        big.NewRat(1001, 1000),
+			PercentChange: big.NewRat(1, 10),
+			PitchCents:    1.7304,
+			SampleRate:    big.NewRat(48048, 1),
+			String:        "23.98 NTSC NDF -> 24 fps",
+		},
+		{
+			Name:          "PALSpeedUp",
+			Transfer:      speed.PALSpeedUp,
+			Factor:        big.NewRat(25, 24),
+			PercentChange: big.NewRat(25, 6),
+			PitchCents:    70.6724,
+			SampleRate:    big.NewRat(50000, 1),
+			String:        "24 fps -> 25 fps",
+		},
+		{
+			Name:          "PALSlowDown",
+			Transfer:      speed.PALSlowDown,
+			Factor:        big.NewRat(24, 25),
+			PercentChange: big.NewRat(-4, 1),
+			PitchCents:    -70.6724,
+			SampleRate:    big.NewRat(46080, 1),
+			String:        "25 fps -> 24 fps",
+		},
+		{
+			Name:          "NTSCToPAL",
+			Transfer:      speed.NTSCToPAL,
+			Factor:        big.NewRat(25025, 24000),
+			PercentChange: big.NewRat(205, 48),
+			PitchCents:    72.4028,
+			SampleRate:    big.NewRat(50050, 1),
+			String:        "23.98 NTSC NDF -> 25 fps",
+		},
+		{
+			Name:          "PALToNTSC",
+			Transfer:      speed.PALToNTSC,
+			Factor:        big.NewRat(24000, 25025),
+			PercentChange: big.NewRat(-8200, 2002),
+			PitchCents:    -72.4028,
+			SampleRate:    big.NewRat(1152000000, 25025),
+			String:        "25 fps -> 23.98 NTSC NDF",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert := assert.New(t)
+			transfer := testCase.Transfer
+
+			assert.Equal(testCase.Factor, transfer.Factor(), "factor")
+			assert.Equal(testCase.PercentChange, transfer.PercentChange(), "percent change")
+			assert.InDelta(testCase.PitchCents, transfer.PitchCents(), 0.0001, "pitch cents")
+			assert.Equal(
+				testCase.SampleRate, transfer.PlaybackSampleRate(tc.SampleRate48k), "sample rate",
+			)
+			assert.Equal(testCase.String, transfer.String(), "string")
+
+			reversed := transfer.Reverse()
+			assert.True(transfer.From().Equal(reversed.To()), "reversed to")
+			assert.True(transfer.To().Equal(reversed.From()), "reversed from")
+
+			factor := reversed.Factor()
+			assert.Equal(big.NewRat(1, 1), factor.Mul(factor, transfer.Factor()), "reversed factor")
+		})
+	}
+}
+
+func TestTransfer_Convert(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Transfer  speed.Transfer
+		Timecode  tc.Timecode
+		Converted string
+		Seconds   *big.Rat
+		Drift     *big.Rat
+	}{
+		{
+			Name:      "PullDown Hour",
+			Transfer:  speed.PullDown,
+			Timecode:  tc.FromFrames(86400, rate.F24),
+			Converted: "01:00:00:00 @ 23.98 NTSC NDF",
+			Seconds:   big.NewRat(18018, 5),
+			Drift:     big.NewRat(18, 5),
+		},
+		{
+			Name:      "PALSpeedUp Hour",
+			Transfer:  speed.PALSpeedUp,
+			Timecode:  tc.FromFrames(86400, rate.F24),
+			Converted: "00:57:36:00 @ 25 fps",
+			Seconds:   big.NewRat(3456, 1),
+			Drift:     big.NewRat(-144, 1),
+		},
+		{
+			Name:      "PullUp Subframes",
+			Transfer:  speed.PullUp,
+			Timecode:  tc.FromSubframes(3, 2, rate.F23_98),
+			Converted: "00:00:00:02 @ 24 fps",
+			Seconds:   big.NewRat(1, 16),
+			Drift:     big.NewRat(-1, 16000),
+		},
+		{
+			Name:      "Negative",
+			Transfer:  speed.PALSlowDown,
+			Timecode:  tc.FromFrames(-25, rate.F25),
+			Converted: "-00:00:01:01 @ 24 fps",
+			Seconds:   big.NewRat(-25, 24),
+			Drift:     big.NewRat(-1, 24),
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			converted := testCase.Transfer.Convert(testCase.Timecode)
+			assert.Equal(testCase.Converted, converted.String(), "converted")
+			assert.Equal(testCase.Seconds, converted.Seconds(), "seconds")
+
+			drift := testCase.Transfer.Drift(testCase.Timecode)
+			assert.Equal(testCase.Drift, drift.Seconds(), "drift")
+			assert.True(testCase.Transfer.To().Equal(drift.Rate()), "drift rate")
+		})
+	}
+}
+
+func TestTransfer_Convert_Rollover(t *testing.T) {
+	assert := assert.New(t)
+
+	timecode := tc.FromFrames(86400*24, rate.F25).WithRollover(tc.Rollover24h)
+	converted := speed.PALSlowDown.Convert(timecode)
+
+	assert.Equal(tc.Rollover24h, converted.Rollover(), "rollover")
+	assert.Equal("00:00:00:00", converted.Timecode(), "timecode")
+
+	drift := speed.PALSlowDown.Drift(timecode)
+	assert.Equal(tc.RolloverNone, drift.Rollover(), "drift rollover")
+}
+
+func TestNew_Errors(t *testing.T) {
+	zeroRate, err := rate.FromInt(0, rate.NTSCNone)
+	if !assert.NoError(t, err, "zero rate") {
+		t.FailNow()
+	}
+
+	cases := []struct {
+		Name string
+		From rate.Framerate
+		To   rate.Framerate
+	}{
+		{Name: "Zero Value From", From: rate.Framerate{}, To: rate.F24},
+		{Name: "Zero Value To", From: rate.F24, To: rate.Framerate{}},
+		{Name: "Zero Playback", From: zeroRate, To: rate.F24},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := speed.New(testCase.From, testCase.To)
+			assert.ErrorIs(t, err, speed.ErrBadTransfer)
+		})
+	}
+}
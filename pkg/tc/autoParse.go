@@ -0,0 +1,198 @@
+package tc
+
+import (
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Format is an enum-like type reporting which representation Parse matched a string
+// against.
+type Format int
+
+const (
+	// FormatUnknown is returned when no format could be matched.
+	FormatUnknown Format = iota
+	// FormatTimecode is a SMPTE timecode string, like '01:00:00:00'.
+	FormatTimecode
+	// FormatRuntime is a real-world runtime string, like '01:00:03.6'.
+	FormatRuntime
+	// FormatFeetAndFrames is a 35mm, 4-perf feet+frames string, like '5400+00'.
+	FormatFeetAndFrames
+	// FormatFrames is a bare frame count, like '86400'.
+	FormatFrames
+	// FormatPremiereTicks is a bare Adobe Premiere Pro tick count, like
+	// '915372057600000'.
+	FormatPremiereTicks
+	// FormatHuman is a unit-suffixed, real-world duration, like '1h 02m 03s 04f'.
+	FormatHuman
+	// FormatISO8601 is an ISO 8601 duration, like 'PT1H0M3.6S'.
+	FormatISO8601
+)
+
+// String implements fmt.Stringer.
+func (format Format) String() string {
+	switch format {
+	case FormatUnknown:
+		return "unknown"
+	case FormatTimecode:
+		return "timecode"
+	case FormatRuntime:
+		return "runtime"
+	case FormatFeetAndFrames:
+		return "feet and frames"
+	case FormatFrames:
+		return "frames"
+	case FormatPremiereTicks:
+		return "premiere ticks"
+	case FormatHuman:
+		return "human duration"
+	case FormatISO8601:
+		return "ISO 8601 duration"
+	default:
+		return "[INVALID FORMAT]"
+	}
+}
+
+// integerRegex matches a bare, optionally negative, integer.
+var integerRegex = regexp.MustCompile(`^-?[0-9]+$`)
+
+/*
+Parse parses value as any of the string representations this package supports, and
+reports which Format was matched.
+
+Priority
+
+value is checked against each format in the following order, and the first match wins:
+
+• FormatFrames / FormatPremiereTicks: a bare integer, like '86400'. See below.
+
+• FormatTimecode: contains a ':' or ';' separator and no '.', like '01:00:00:00'. A
+timecode with all four places may also have a field or subframe suffix, like
+'01:00:00:00.1' or '01:00:00:00+50'.
+
+• FormatISO8601: an ISO 8601 duration, like 'PT1H0M3.6S'.
+
+• FormatHuman: a unit-suffixed duration, like '1h 02m 03s 04f' or '3.5s'.
+
+• FormatRuntime: contains a '.', like '01:00:03.6' or '3.5'.
+
+• FormatFeetAndFrames: contains a '+', like '5400+00'.
+
+Because of this order, a partial timecode made up of only a frames place (like '12')
+will be parsed as a frame count, and a timecode without a frames place will never be
+mistaken for a runtime.
+
+Bare integers
+
+A bare integer could be a frame count or a Premiere Pro tick count. Premiere only ever
+reports ticks which land on a frame boundary, so an integer is treated as a frame
+count unless it is also a valid, whole-frame tick count for framerate. In that case
+ErrAmbiguousFormat is returned, and the caller should use ParseAs to say which format
+was intended. '0' is the same in both formats, and is always parsed as a frame count.
+*/
+func Parse(value string, framerate rate.Framerate) (Timecode, Format, error) {
+	return ParseAs(value, framerate, allFormats...)
+}
+
+// allFormats is the list of formats Parse will try.
+var allFormats = []Format{
+	FormatTimecode,
+	FormatRuntime,
+	FormatFeetAndFrames,
+	FormatFrames,
+	FormatPremiereTicks,
+	FormatHuman,
+	FormatISO8601,
+}
+
+// ParseAs works like Parse, but only tries the passed formats. Each format is still
+// checked using the priority order laid out by Parse.
+//
+// Passing a single format for a bare integer, like FormatPremiereTicks, is how to
+// resolve an ErrAmbiguousFormat error returned by Parse.
+func ParseAs(value string, framerate rate.Framerate, formats ...Format) (Timecode, Format, error) {
+	allowed := make(map[Format]bool, len(formats))
+	for _, format := range formats {
+		allowed[format] = true
+	}
+
+	isInteger := integerRegex.MatchString(value)
+
+	switch {
+	case isInteger && (allowed[FormatFrames] || allowed[FormatPremiereTicks]):
+		return parseInteger(value, framerate, allowed[FormatFrames], allowed[FormatPremiereTicks])
+	case allowed[FormatTimecode] && isTimecodeLike(value):
+		timecode, err := FromTimecode(value, framerate)
+		return timecode, FormatTimecode, err
+	case allowed[FormatTimecode] && isInteger:
+		// A bare integer can still be a partial timecode of only a frames place if the
+		// caller has not asked for frame or tick counts.
+		timecode, err := FromTimecode(value, framerate)
+		return timecode, FormatTimecode, err
+	case allowed[FormatISO8601] && isoDurationRegex.MatchString(value):
+		timecode, err := FromISO8601(value, framerate)
+		return timecode, FormatISO8601, err
+	case allowed[FormatHuman] && isHumanLike(value):
+		timecode, err := FromHuman(value, framerate)
+		return timecode, FormatHuman, err
+	case allowed[FormatRuntime] && strings.Contains(value, "."):
+		timecode, err := FromRuntime(value, framerate)
+		return timecode, FormatRuntime, err
+	case allowed[FormatFeetAndFrames] && strings.Contains(value, "+"):
+		timecode, err := FromFeetAndFrames(value, framerate)
+		return timecode, FormatFeetAndFrames, err
+	default:
+		err := notRecognized("expected a timecode, runtime, feet+frames, duration or integer value")
+		return Timecode{}, FormatUnknown, withInput(err, value, FormatUnknown, nil)
+	}
+}
+
+// isTimecodeLike returns true if value should be parsed as a timecode rather than a
+// runtime or feet+frames value.
+func isTimecodeLike(value string) bool {
+	separators := strings.Count(value, ":") + strings.Count(value, ";")
+	if separators == 0 {
+		return false
+	}
+
+	// Runtimes have at most two separators, so a value with a decimal point is only a
+	// timecode if every place is present, like '01:00:00:00.1'.
+	return !strings.Contains(value, ".") || separators >= 3
+}
+
+// isHumanLike returns true if value is a unit-suffixed duration. Every place of a
+// duration is optional, so we also check that at least one unit is present.
+func isHumanLike(value string) bool {
+	return humanRegex.MatchString(value) && strings.ContainsAny(value, "hmsfHMSF")
+}
+
+// parseInteger parses a bare integer as either a frame count or a Premiere Pro tick
+// count.
+func parseInteger(
+	value string, framerate rate.Framerate, allowFrames bool, allowTicks bool,
+) (Timecode, Format, error) {
+	count, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		parseErr := notRecognized("%v", err)
+		return Timecode{}, FormatUnknown, withInput(parseErr, value, FormatUnknown, nil)
+	}
+
+	if !allowFrames {
+		return FromPremiereTicks(count, framerate), FormatPremiereTicks, nil
+	}
+
+	// If this count of ticks would land exactly on a frame, we cannot know which
+	// format the caller intended.
+	if allowTicks && count != 0 && FromPremiereTicks(count, framerate).PremiereTicks() == count {
+		err := fieldError(
+			ErrAmbiguousFormat,
+			"",
+			"value is both a valid frame count and a valid Premiere tick count",
+		)
+		return Timecode{}, FormatUnknown, withInput(err, value, FormatUnknown, nil)
+	}
+
+	return FromFrames(count, framerate), FormatFrames, nil
+}
@@ -0,0 +1,191 @@
+package tc_test
+
+import (
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		In             string
+		Rate           rate.Framerate
+		ExpectedFormat tc.Format
+		Expected       string
+	}{
+		{
+			In:             "01:00:00:00",
+			Rate:           rate.F23_98,
+			ExpectedFormat: tc.FormatTimecode,
+			Expected:       "01:00:00:00",
+		},
+		{
+			In:             "00:01:00;02",
+			Rate:           rate.F29_97Df,
+			ExpectedFormat: tc.FormatTimecode,
+			Expected:       "00:01:00;02",
+		},
+		{
+			In:             "3:12",
+			Rate:           rate.F23_98,
+			ExpectedFormat: tc.FormatTimecode,
+			Expected:       "00:00:03:12",
+		},
+		{
+			In:             "01:00:00:00+25",
+			Rate:           rate.F29_97Ndf,
+			ExpectedFormat: tc.FormatTimecode,
+			Expected:       "01:00:00:00",
+		},
+		{
+			In:             "01:00:03.6",
+			Rate:           rate.F23_98,
+			ExpectedFormat: tc.FormatRuntime,
+			Expected:       "01:00:00:00",
+		},
+		{
+			In:             "0.5",
+			Rate:           rate.F24,
+			ExpectedFormat: tc.FormatRuntime,
+			Expected:       "00:00:00:12",
+		},
+		{
+			In:             "1h 02m 03s 04f",
+			Rate:           rate.F24,
+			ExpectedFormat: tc.FormatHuman,
+			Expected:       "01:02:03:04",
+		},
+		{
+			In:             "0.5s",
+			Rate:           rate.F24,
+			ExpectedFormat: tc.FormatHuman,
+			Expected:       "00:00:00:12",
+		},
+		{
+			In:             "PT1H0M3.6S",
+			Rate:           rate.F23_98,
+			ExpectedFormat: tc.FormatISO8601,
+			Expected:       "01:00:00:00",
+		},
+		{
+			In:             "5400+00",
+			Rate:           rate.F23_98,
+			ExpectedFormat: tc.FormatFeetAndFrames,
+			Expected:       "01:00:00:00",
+		},
+		{
+			In:             "-213+07",
+			Rate:           rate.F23_98,
+			ExpectedFormat: tc.FormatFeetAndFrames,
+			Expected:       "-00:02:22:07",
+		},
+		{
+			In:             "86400",
+			Rate:           rate.F23_98,
+			ExpectedFormat: tc.FormatFrames,
+			Expected:       "01:00:00:00",
+		},
+		{
+			In:             "0",
+			Rate:           rate.F23_98,
+			ExpectedFormat: tc.FormatFrames,
+			Expected:       "00:00:00:00",
+		},
+		{
+			In:             "-24",
+			Rate:           rate.F24,
+			ExpectedFormat: tc.FormatFrames,
+			Expected:       "-00:00:01:00",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.In, func(t *testing.T) {
+			assert := assert.New(t)
+
+			parsed, format, err := tc.Parse(testCase.In, testCase.Rate)
+			if !assert.NoError(err, "parse") {
+				t.FailNow()
+			}
+
+			assert.Equal(testCase.ExpectedFormat, format, "format")
+			assert.Equal(testCase.Expected, parsed.Timecode(), "timecode")
+		})
+	}
+}
+
+func TestParse_AmbiguousTicks(t *testing.T) {
+	assert := assert.New(t)
+
+	_, format, err := tc.Parse("915372057600000", rate.F23_98)
+	assert.ErrorIs(err, tc.ErrParseTimecode, "is parse err")
+	assert.ErrorIs(err, tc.ErrAmbiguousFormat, "is correct sub err")
+	assert.Equal(tc.FormatUnknown, format, "format")
+}
+
+func TestParseAs(t *testing.T) {
+	cases := []struct {
+		Name           string
+		In             string
+		Formats        []tc.Format
+		ExpectedFormat tc.Format
+		Expected       string
+	}{
+		{
+			Name:           "ticks",
+			In:             "915372057600000",
+			Formats:        []tc.Format{tc.FormatPremiereTicks},
+			ExpectedFormat: tc.FormatPremiereTicks,
+			Expected:       "01:00:00:00",
+		},
+		{
+			Name:           "frames",
+			In:             "915372057600000",
+			Formats:        []tc.Format{tc.FormatFrames},
+			ExpectedFormat: tc.FormatFrames,
+			Expected:       "10594584000:00:00:00",
+		},
+		{
+			Name:           "partial timecode",
+			In:             "12",
+			Formats:        []tc.Format{tc.FormatTimecode, tc.FormatRuntime},
+			ExpectedFormat: tc.FormatTimecode,
+			Expected:       "00:00:00:12",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			parsed, format, err := tc.ParseAs(testCase.In, rate.F23_98, testCase.Formats...)
+			if !assert.NoError(err, "parse") {
+				t.FailNow()
+			}
+
+			assert.Equal(testCase.ExpectedFormat, format, "format")
+			assert.Equal(testCase.Expected, parsed.Timecode(), "timecode")
+		})
+	}
+}
+
+func TestParse_ErrFormat(t *testing.T) {
+	cases := []string{"not a timecode", "", "01:00+00:00"}
+
+	for _, testCase := range cases {
+		t.Run(testCase, func(t *testing.T) {
+			assert := assert.New(t)
+
+			_, _, err := tc.Parse(testCase, rate.F24)
+			assert.ErrorIs(err, tc.ErrParseTimecode, "is parse err")
+			assert.ErrorIs(err, tc.ErrFormatNotRecognized, "is correct sub err")
+		})
+	}
+}
+
+func TestFormat_String(t *testing.T) {
+	assert.Equal(t, "feet and frames", tc.FormatFeetAndFrames.String())
+	assert.Equal(t, "human duration", tc.FormatHuman.String())
+	assert.Equal(t, "[INVALID FORMAT]", tc.Format(100).String())
+}
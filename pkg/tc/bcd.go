@@ -0,0 +1,337 @@
+package tc
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"math/big"
+	"strconv"
+)
+
+// Bit masks of the flags in a SMPTE 12M time address word. See PackBCD.
+const (
+	bcdDropFrame    uint32 = 1 << 6
+	bcdColorFrame   uint32 = 1 << 7
+	bcdFieldMark    uint32 = 1 << 15
+	bcdBinaryGroup0 uint32 = 1 << 23
+	bcdBinaryGroup1 uint32 = 1 << 30
+	bcdBinaryGroup2 uint32 = 1 << 31
+)
+
+// bcdMaxFrames is the highest timebase whose frames place can be stored directly in a
+// time address word. Faster rates are stored as frame pairs.
+const bcdMaxFrames int64 = 30
+
+// BCDFlags holds the flag bits of a SMPTE 12M time address word.
+type BCDFlags struct {
+	// DropFrame is set when the timecode is drop-frame.
+	DropFrame bool
+	// ColorFrame is set when the timecode is locked to the color framing sequence of
+	// the video.
+	ColorFrame bool
+	// FieldMark identifies the field, or for rates above 30 fps, the second frame of a
+	// frame pair.
+	FieldMark bool
+	// BinaryGroup0 is the first binary group flag, which, with BinaryGroup1 and
+	// BinaryGroup2, sets the format of the UserBits.
+	BinaryGroup0 bool
+	// BinaryGroup1 is the second binary group flag.
+	BinaryGroup1 bool
+	// BinaryGroup2 is the third binary group flag.
+	BinaryGroup2 bool
+}
+
+// mask returns the bits of the flags that are set.
+func (flags BCDFlags) mask() uint32 {
+	var word uint32
+	for _, flag := range []struct {
+		set  bool
+		mask uint32
+	}{
+		{flags.DropFrame, bcdDropFrame},
+		{flags.ColorFrame, bcdColorFrame},
+		{flags.FieldMark, bcdFieldMark},
+		{flags.BinaryGroup0, bcdBinaryGroup0},
+		{flags.BinaryGroup1, bcdBinaryGroup1},
+		{flags.BinaryGroup2, bcdBinaryGroup2},
+	} {
+		if flag.set {
+			word |= flag.mask
+		}
+	}
+	return word
+}
+
+// UnpackBCDFlags returns the flag bits of a SMPTE 12M time address word packed by
+// Timecode.PackBCD.
+func UnpackBCDFlags(word uint32) BCDFlags {
+	return BCDFlags{
+		DropFrame:    word&bcdDropFrame != 0,
+		ColorFrame:   word&bcdColorFrame != 0,
+		FieldMark:    word&bcdFieldMark != 0,
+		BinaryGroup0: word&bcdBinaryGroup0 != 0,
+		BinaryGroup1: word&bcdBinaryGroup1 != 0,
+		BinaryGroup2: word&bcdBinaryGroup2 != 0,
+	}
+}
+
+/*
+PackBCD returns the timecode as a 32-bit SMPTE 12M binary-coded-decimal time address
+word, with the drop-frame flag set for drop-frame rates.
+
+What it is
+
+Hardware and many file formats store timecode as one BCD digit per nibble, with the
+hours in the most significant byte: 01:02:03:04 is 0x01020304. The tens digits do not
+need all four bits, so the spare bits hold flags:
+
+	bit 6:  drop-frame          bit 23: binary group flag 0
+	bit 7:  color frame         bit 30: binary group flag 1
+	bit 15: field mark          bit 31: binary group flag 2
+
+The value is wrapped into a single day, as with Rollover24h, since the word has no
+sign or days place. For rates above 30 fps, the frames place counts frame pairs, and
+FieldMark is set for the second frame of each pair. For rates above 60 fps, the frames
+are first counted as in NotationHFR, and the HFRIndex is not stored.
+
+Use PackBCDFlags to set the other flags.
+
+Where you see it
+
+• DPX and Cineon file headers.
+
+• SMPTE RP 188 ancillary timecode, and MXF system items.
+
+• QuickTime 'tmcd' timecode track samples.
+*/
+func (tc Timecode) PackBCD() uint32 {
+	return tc.PackBCDFlags(BCDFlags{})
+}
+
+// PackBCDFlags works like PackBCD, but also sets any flags which are set in flags. The
+// DropFrame and FieldMark flags are always set when the Timecode requires them.
+//
+// Note: this method will panic on framerates where the timebase is not a whole integer.
+func (tc Timecode) PackBCDFlags(flags BCDFlags) uint32 {
+	sections := NotationHFR.Sections(tc.WithRollover(Rollover24h))
+
+	_, baseTimebase := hfrMultiplier(tc.rate)
+	if baseTimebase.Num().Int64() > bcdMaxFrames {
+		flags.FieldMark = flags.FieldMark || sections.Frames%2 == 1
+		sections.Frames /= 2
+	}
+
+	flags.DropFrame = flags.DropFrame || tc.rate.NTSC() == rate.NTSCDrop
+
+	word := packBCDByte(sections.Hours)<<24 |
+		packBCDByte(sections.Minutes)<<16 |
+		packBCDByte(sections.Seconds)<<8 |
+		packBCDByte(sections.Frames)
+
+	return word | flags.mask()
+}
+
+// packBCDByte returns value, which must be less than 100, as two BCD digits.
+func packBCDByte(value int64) uint32 {
+	return uint32(value/10)<<4 | uint32(value%10)
+}
+
+// bcdPlace describes a place in a time address word.
+type bcdPlace struct {
+	// name is the ParseError field name of the place.
+	name string
+	// shift is the bit offset of the units digit.
+	shift uint
+	// tensMask masks the bits of the tens digit, once shifted down.
+	tensMask uint32
+	// max is the highest value SMPTE 12M allows in the place, or 0 if it depends on the
+	// framerate.
+	max int64
+}
+
+// bcdPlaces lists the places of a time address word from most to least significant.
+var bcdPlaces = []bcdPlace{
+	{name: fieldHours, shift: 24, tensMask: 0x3, max: hoursPerDay - 1},
+	{name: fieldMinutes, shift: 16, tensMask: 0x7, max: minutesPerHour - 1},
+	{name: fieldSeconds, shift: 8, tensMask: 0x7, max: secondsPerMinute - 1},
+	{name: fieldFrames, shift: 0, tensMask: 0x3},
+}
+
+// FromBCD converts a 32-bit SMPTE 12M binary-coded-decimal time address word to a
+// Timecode. See Timecode.PackBCD for the format.
+//
+// If the drop-frame flag is set, the Timecode will use the drop-frame version of
+// framerate, so a 29.97 NTSC NDF or 30 fps framerate will become 29.97 NTSC DF. Words
+// without the flag keep framerate as-is, since some hardware does not set it.
+//
+// ErrBadBCDValue is returned if a place holds a digit over 9, or a value out of range
+// for its place: hours over 23, minutes or seconds over 59, or a frames place at or
+// over the timebase it is counted at. An error wrapping rate.ErrBadDropFrameRate is
+// returned if the drop-frame flag is set, but framerate cannot be drop-frame.
+func FromBCD(word uint32, framerate rate.Framerate) (Timecode, error) {
+	flags := UnpackBCDFlags(word)
+	if flags.DropFrame && framerate.NTSC() != rate.NTSCDrop {
+		var err error
+		framerate, err = dropFrameRate(framerate)
+		if err != nil {
+			return Timecode{}, err
+		}
+	}
+
+	multiplier, baseTimebase := hfrMultiplier(framerate)
+	isPairs := baseTimebase.Cmp(big.NewRat(bcdMaxFrames, 1)) > 0
+
+	// The frames place can hold any value below the timebase it is counted at, which is
+	// halved for rates stored as frame pairs.
+	framesTimebase := new(big.Rat).Set(baseTimebase)
+	if isPairs {
+		framesTimebase.Quo(framesTimebase, big.NewRat(2, 1))
+	}
+
+	values := make([]int64, len(bcdPlaces))
+	for i, place := range bcdPlaces {
+		units := (word >> place.shift) & 0xF
+		tens := (word >> (place.shift + 4)) & place.tensMask
+		if units > 9 {
+			return Timecode{}, fmt.Errorf(
+				"%w: %v units digit 0x%X in word 0x%08X", ErrBadBCDValue, place.name, units, word,
+			)
+		}
+
+		value := int64(tens*10 + units)
+		if place.max != 0 && value > place.max {
+			return Timecode{}, fmt.Errorf(
+				"%w: %v value %v must be at most %v in word 0x%08X",
+				ErrBadBCDValue,
+				place.name,
+				value,
+				place.max,
+				word,
+			)
+		}
+		if place.max == 0 && big.NewRat(value, 1).Cmp(framesTimebase) >= 0 {
+			return Timecode{}, fmt.Errorf(
+				"%w: %v value %v must be less than %v for %v in word 0x%08X",
+				ErrBadBCDValue,
+				place.name,
+				value,
+				framesTimebase.RatString(),
+				framerate,
+				word,
+			)
+		}
+
+		values[i] = value
+	}
+
+	sections := TimecodeSections{
+		Hours:   values[0],
+		Minutes: values[1],
+		Seconds: values[2],
+		Frames:  values[3],
+	}
+
+	if isPairs {
+		sections.Frames *= 2
+		if flags.FieldMark {
+			sections.Frames++
+		}
+	}
+
+	frames, err := framesFromSections(
+		sections, baseTimebase, framerate.NTSC() == rate.NTSCDrop,
+	)
+	if err != nil {
+		return Timecode{}, err
+	}
+
+	return FromFrames(frames*multiplier, framerate), nil
+}
+
+/*
+UserBits holds the 32 user bits that travel alongside a SMPTE 12M time address, as 8
+binary groups of 4 bits each.
+
+What it is
+
+User bits carry extra metadata with each frame, like a reel number, a shoot date or a
+second timecode. How they are interpreted is set by the binary group flags of the time
+address: see BCDFlags.
+
+Binary group 1 is stored in the least significant nibble, so the value prints in the
+reverse of the order the groups are sent in.
+
+Where you see it
+
+• Camera and recorder user bits settings, often set to the shoot date.
+
+• DPX headers, next to the timecode.
+
+• SMPTE RP 188 ancillary timecode.
+*/
+type UserBits uint32
+
+// userBitsGroups is the number of binary groups in UserBits.
+const userBitsGroups = 8
+
+// Group returns the 4-bit value of binary group number, from 1 to 8.
+//
+// Note: this method will panic if number is out of range.
+func (bits UserBits) Group(number int) uint8 {
+	checkUserBitsGroup(number)
+	return uint8(bits>>(4*(number-1))) & 0xF
+}
+
+// WithGroup returns a copy of bits with binary group number, from 1 to 8, set to the
+// lowest 4 bits of value.
+//
+// Note: this method will panic if number is out of range.
+func (bits UserBits) WithGroup(number int, value uint8) UserBits {
+	checkUserBitsGroup(number)
+	shift := uint(4 * (number - 1))
+	bits &^= 0xF << shift
+	return bits | UserBits(value&0xF)<<shift
+}
+
+// checkUserBitsGroup panics if number is not a valid binary group number.
+func checkUserBitsGroup(number int) {
+	if number < 1 || number > userBitsGroups {
+		panic(fmt.Sprintf(
+			"binary group %v out of range, must be 1 to %v", number, userBitsGroups,
+		))
+	}
+}
+
+// Bytes returns the user bits as 4 bytes, with binary groups 1 and 2 in the first byte.
+// This is how 8-bit character data is stored in the user bits.
+func (bits UserBits) Bytes() [4]byte {
+	return [4]byte{byte(bits), byte(bits >> 8), byte(bits >> 16), byte(bits >> 24)}
+}
+
+// UserBitsFromBytes returns UserBits holding data. See UserBits.Bytes.
+func UserBitsFromBytes(data [4]byte) UserBits {
+	return UserBits(data[0]) | UserBits(data[1])<<8 | UserBits(data[2])<<16 | UserBits(data[3])<<24
+}
+
+// String implements fmt.Stringer, formatting the bits as 8 hex digits with binary
+// group 8 first, like camera displays: (ex: 2021A0F1).
+func (bits UserBits) String() string {
+	return fmt.Sprintf("%08X", uint32(bits))
+}
+
+// ParseUserBits parses UserBits from the 8 hex digits returned by UserBits.String.
+//
+// Errors are returned as a *ParseError wrapping ErrFormatNotRecognized.
+func ParseUserBits(value string) (UserBits, error) {
+	if len(value) != userBitsGroups {
+		err := notRecognized("expected 8 hex digits like '2021A0F1'")
+		return 0, withInput(err, value, FormatUnknown, nil)
+	}
+
+	bits, err := strconv.ParseUint(value, 16, 32)
+	if err != nil {
+		parseErr := notRecognized("expected 8 hex digits like '2021A0F1'")
+		return 0, withInput(parseErr, value, FormatUnknown, nil)
+	}
+
+	return UserBits(bits), nil
+}
@@ -0,0 +1,211 @@
+package tc_test
+
+import (
+	"errors"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTimecode_PackBCD(t *testing.T) {
+	cases := []struct {
+		Timecode string
+		Rate     rate.Framerate
+		Word     uint32
+		Flags    tc.BCDFlags
+	}{
+		{
+			Timecode: "01:02:03:04",
+			Rate:     rate.F24,
+			Word:     0x01020304,
+		},
+		{
+			Timecode: "23:59:59:23",
+			Rate:     rate.F23_98,
+			Word:     0x23595923,
+		},
+		{
+			Timecode: "00:01:00;02",
+			Rate:     rate.F29_97Df,
+			Word:     0x00010042,
+			Flags:    tc.BCDFlags{DropFrame: true},
+		},
+		{
+			Timecode: "10:00:00;00",
+			Rate:     rate.F29_97Df,
+			Word:     0x10000040,
+			Flags:    tc.BCDFlags{DropFrame: true},
+		},
+		{
+			Timecode: "01:00:00:49",
+			Rate:     mustRate(50, rate.NTSCNone),
+			Word:     0x01008024,
+			Flags:    tc.BCDFlags{FieldMark: true},
+		},
+		{
+			Timecode: "00:01:00;04",
+			Rate:     rate.F59_94Df,
+			Word:     0x00010042,
+			Flags:    tc.BCDFlags{DropFrame: true},
+		},
+		{
+			Timecode: "01:00:00:59.1",
+			Rate:     mustRate(120, rate.NTSCNone),
+			Word:     0x01008029,
+			Flags:    tc.BCDFlags{FieldMark: true},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Timecode+" @ "+testCase.Rate.String(), func(t *testing.T) {
+			timecode, err := tc.NotationHFR.FromTimecode(testCase.Timecode, testCase.Rate)
+			if !assert.NoError(t, err, "parse timecode") {
+				t.FailNow()
+			}
+
+			word := timecode.PackBCD()
+			assert.Equal(t, testCase.Word, word, "word: %08X", word)
+			assert.Equal(t, testCase.Flags, tc.UnpackBCDFlags(word), "flags")
+
+			parsed, err := tc.FromBCD(word, testCase.Rate)
+			if !assert.NoError(t, err, "from bcd") {
+				t.FailNow()
+			}
+
+			// The HFR index is not stored in the word.
+			expected, _ := tc.NotationHFR.FromTimecode(testCase.Timecode, testCase.Rate)
+			expectedSections := tc.NotationHFR.Sections(expected)
+			expectedSections.HFRIndex = 0
+			parsedSections := tc.NotationHFR.Sections(parsed)
+			assert.Equal(t, expectedSections, parsedSections, "parsed")
+		})
+	}
+}
+
+func TestTimecode_PackBCD_Wrap(t *testing.T) {
+	assert.Equal(t, uint32(0x23595900), tc.FromFrames(-24, rate.F24).PackBCD(), "negative")
+	assert.Equal(
+		t, uint32(0x01000000), tc.FromFrames(86400*25, rate.F24).PackBCD(), "over 24 hours",
+	)
+}
+
+func TestTimecode_PackBCDFlags(t *testing.T) {
+	flags := tc.BCDFlags{
+		ColorFrame:   true,
+		BinaryGroup0: true,
+		BinaryGroup1: true,
+		BinaryGroup2: true,
+	}
+
+	word := tc.FromFrames(0, rate.F29_97Df).PackBCDFlags(flags)
+	assert.Equal(t, uint32(0xC08000C0), word, "word: %08X", word)
+
+	flags.DropFrame = true
+	assert.Equal(t, flags, tc.UnpackBCDFlags(word))
+}
+
+func TestFromBCD_DropFrameFlag(t *testing.T) {
+	parsed, err := tc.FromBCD(0x00010042, rate.F29_97Ndf)
+	if assert.NoError(t, err) {
+		assert.Equal(t, rate.F29_97Df, parsed.Rate(), "rate")
+		assert.Equal(t, "00:01:00;02", parsed.Timecode(), "timecode")
+	}
+
+	parsed, err = tc.FromBCD(0x00010042, rate.F30)
+	if assert.NoError(t, err) {
+		assert.Equal(t, rate.F29_97Df, parsed.Rate(), "rate")
+	}
+
+	// Some hardware leaves the flag off, so we should not drop the drop-frame status.
+	parsed, err = tc.FromBCD(0x00010002, rate.F29_97Df)
+	if assert.NoError(t, err) {
+		assert.Equal(t, rate.F29_97Df, parsed.Rate(), "rate")
+	}
+}
+
+func TestFromBCD_Errors(t *testing.T) {
+	cases := []struct {
+		Name string
+		Word uint32
+		Rate rate.Framerate
+		Err  error
+	}{
+		{Name: "Frames Digit", Word: 0x0100000A, Rate: rate.F24, Err: tc.ErrBadBCDValue},
+		{Name: "Hours Digit", Word: 0x0F000000, Rate: rate.F24, Err: tc.ErrBadBCDValue},
+		{Name: "Hours Tens", Word: 0x30000000, Rate: rate.F24, Err: tc.ErrBadBCDValue},
+		{Name: "Hours 24", Word: 0x24000000, Rate: rate.F24, Err: tc.ErrBadBCDValue},
+		{Name: "Minutes Tens", Word: 0x00600000, Rate: rate.F24, Err: tc.ErrBadBCDValue},
+		{Name: "Seconds Tens", Word: 0x00007000, Rate: rate.F24, Err: tc.ErrBadBCDValue},
+		{Name: "Frames Tens", Word: 0x00000030, Rate: rate.F24, Err: tc.ErrBadBCDValue},
+		{Name: "Frames Timebase", Word: 0x00000024, Rate: rate.F24, Err: tc.ErrBadBCDValue},
+		{Name: "Frames 29.97", Word: 0x00000030, Rate: rate.F29_97Ndf, Err: tc.ErrBadBCDValue},
+		{Name: "Frame Pairs", Word: 0x00000030, Rate: rate.F59_94Ndf, Err: tc.ErrBadBCDValue},
+		{Name: "Drop Frame Rate", Word: 0x01000040, Rate: rate.F24, Err: rate.ErrBadDropFrameRate},
+		{Name: "Dropped Frame", Word: 0x00010041, Rate: rate.F29_97Df, Err: tc.ErrBadDropFrameValue},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := tc.FromBCD(testCase.Word, testCase.Rate)
+			assert.True(t, errors.Is(err, testCase.Err), "error is %v: %v", testCase.Err, err)
+		})
+	}
+}
+
+func TestFromBCD_MaxValues(t *testing.T) {
+	cases := []struct {
+		Word     uint32
+		Rate     rate.Framerate
+		Expected string
+	}{
+		{Word: 0x23595923, Rate: rate.F24, Expected: "23:59:59:23"},
+		{Word: 0x23595924, Rate: rate.F25, Expected: "23:59:59:24"},
+		{Word: 0x23595969, Rate: rate.F29_97Df, Expected: "23:59:59;29"},
+		{Word: 0x23595929 | 0x8000, Rate: rate.F59_94Ndf, Expected: "23:59:59:59"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Expected, func(t *testing.T) {
+			parsed, err := tc.FromBCD(testCase.Word, testCase.Rate)
+			if assert.NoError(t, err) {
+				assert.Equal(t, testCase.Expected, parsed.Timecode())
+			}
+		})
+	}
+}
+
+func TestUserBits(t *testing.T) {
+	bits := tc.UserBits(0)
+	for group := 1; group <= 8; group++ {
+		bits = bits.WithGroup(group, uint8(group))
+	}
+
+	assert.Equal(t, "87654321", bits.String())
+	assert.Equal(t, uint8(3), bits.Group(3))
+	assert.Equal(t, [4]byte{0x21, 0x43, 0x65, 0x87}, bits.Bytes())
+	assert.Equal(t, bits, tc.UserBitsFromBytes(bits.Bytes()))
+
+	bits = bits.WithGroup(8, 0xFA)
+	assert.Equal(t, "A7654321", bits.String(), "only the low nibble is set")
+
+	parsed, err := tc.ParseUserBits("a7654321")
+	if assert.NoError(t, err) {
+		assert.Equal(t, bits, parsed)
+	}
+
+	assert.Panics(t, func() { bits.Group(0) })
+	assert.Panics(t, func() { bits.WithGroup(9, 0) })
+}
+
+func TestParseUserBits_Errors(t *testing.T) {
+	for _, value := range []string{"", "1234567", "123456789", "1234567G", "-1234567"} {
+		t.Run(value, func(t *testing.T) {
+			_, err := tc.ParseUserBits(value)
+			assert.ErrorIs(t, err, tc.ErrFormatNotRecognized)
+
+			var parseErr *tc.ParseError
+			assert.True(t, errors.As(err, &parseErr), "is ParseError")
+		})
+	}
+}
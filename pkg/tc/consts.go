@@ -1,27 +1,39 @@
 package tc
 
-import "math/big"
+import (
+	"math/big"
+	"time"
+)
 
 // cache some constants for seconds per interval
 const (
 	secondsPerMinute int64 = 60
-	secondsPerHour         = secondsPerMinute * 60
+	minutesPerHour   int64 = 60
+	secondsPerHour         = secondsPerMinute * minutesPerHour
 )
 
+// millisecondsPerSecond is the number of milliseconds in a second.
+const millisecondsPerSecond int64 = 1000
+
+// nanosecondsPerSecond is the number of nanoseconds, the unit of time.Duration, in a
+// second.
+const nanosecondsPerSecond = int64(time.Second)
+
 var (
-	secondsPerMinuteRat = big.NewRat(secondsPerMinute, 1)
-	secondsPerHourRat   = big.NewRat(secondsPerHour, 1)
+	secondsPerSecondRat      = big.NewRat(1, 1)
+	secondsPerMinuteRat      = big.NewRat(secondsPerMinute, 1)
+	secondsPerHourRat        = big.NewRat(secondsPerHour, 1)
+	millisecondsPerSecondRat = big.NewRat(millisecondsPerSecond, 1)
+	nanosecondsPerSecondRat  = big.NewRat(nanosecondsPerSecond, 1)
 )
 
-// premiereTicksPerSecond is the number of ticks Adobe Premiere Pro tracks within a
-// seconds.
-const premiereTicksPerSecond int64 = 254016000000
-
-// framesPerFoot is the number of frames in a foot of 35mm, 4-perf film.
-const framesPerFoot int64 = 16
+// SubframesPerFrame is the number of subframes in a frame used by the subframe suffix
+// of a timecode, like '01:00:00:00+50'. This matches Pro Tools, which divides a frame
+// into 100 subframes.
+const SubframesPerFrame int64 = 100
 
-// premiereTicksPerSecondsRat is the rational version of premiereTicksPerSecond.
-var premiereTicksPerSecondsRat = big.NewRat(premiereTicksPerSecond, 1)
+// fieldsPerFrame is the number of fields that make up an interlaced frame.
+const fieldsPerFrame int64 = 2
 
 // zeroRat will be used to check if rational values are negative.
 var zeroRat = big.NewRat(0, 1)
@@ -1,8 +1,6 @@
 package tc
 
 import (
-	"fmt"
-	"github.com/opencinemac/vtc-go/pkg/rate"
 	"math"
 )
 
@@ -11,11 +9,9 @@ import (
 //
 // algorithm adapted from:
 // https://www.davidheidelberger.com/2010/06/10/drop-frame-timecode/
-func dropFrameNumAdjustment(frameNumber int64, framerate rate.Framerate) int64 {
-	// The timebase of a drop-frame timecode will always be a whole-number, so we can
-	// just get the numerator as our timebase.
-	timebase := framerate.Timebase().Num().Int64()
-
+//
+// The timebase of a drop-frame timecode will always be a whole-number.
+func dropFrameNumAdjustment(frameNumber int64, timebase int64) int64 {
 	// Get the number of frames we need to drop each time we drop frames
 	// (ex: 2 for 29.97).
 	dropFrames := dropFramesForTimebase(timebase)
@@ -46,7 +42,7 @@ func dropFrameNumAdjustment(frameNumber int64, framerate rate.Framerate) int64 {
 
 	// Remove the first full minute (we don't drop until the next minute) and add the
 	// drop-rate to the adjustment.
-	frames -= timebase
+	frames -= framesPerMinute
 	adjustment += dropFrames
 
 	// Get the number of remaining drop-minutes present, and add a drop adjustment for
@@ -59,19 +55,22 @@ func dropFrameNumAdjustment(frameNumber int64, framerate rate.Framerate) int64 {
 
 // dropFrameParseAdjustment creates a frame number adjustment for parsing drop-frame
 // timecode.
-func dropFrameParseAdjustment(sections TimecodeSections, framerate rate.Framerate) (int64, error) {
-	// Drop-frame timebases are always whole-numbers.
-	timebase := framerate.Timebase().Num().Int64()
+//
+// Drop-frame timebases are always whole-numbers.
+func dropFrameParseAdjustment(sections TimecodeSections, timebase int64) (int64, error) {
 	dropFrames := dropFramesForTimebase(timebase)
 
-	hasBadFrames := sections.Frames < dropFrames
+	// Frames are only dropped from the first second of each minute.
+	hasBadFrames := sections.Frames < dropFrames && sections.Seconds == 0
 	isTenthMinute := sections.Minutes%10 == 0
 
 	if hasBadFrames && !isTenthMinute {
-		return 0, fmt.Errorf(
-			"%w: found frame value of '%v', should be < '%v'",
+		return 0, fieldError(
 			ErrBadDropFrameValue,
+			fieldFrames,
+			"frames field %v is dropped at the start of minute %v, should be >= %v",
 			sections.Frames,
+			sections.Minutes,
 			dropFrames,
 		)
 	}
@@ -0,0 +1,89 @@
+package tc_test
+
+import (
+	"errors"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestDropFrame_MinuteBoundaries checks the frames on either side of each minute
+// boundary, where drop-frame timecode skips frame numbers.
+func TestDropFrame_MinuteBoundaries(t *testing.T) {
+	cases := []struct {
+		Rate     rate.Framerate
+		Frames   int64
+		Timecode string
+	}{
+		{Rate: rate.F29_97Df, Frames: 1799, Timecode: "00:00:59;29"},
+		{Rate: rate.F29_97Df, Frames: 1800, Timecode: "00:01:00;02"},
+		{Rate: rate.F29_97Df, Frames: 1801, Timecode: "00:01:00;03"},
+		{Rate: rate.F29_97Df, Frames: 3568, Timecode: "00:01:59;00"},
+		{Rate: rate.F29_97Df, Frames: 3569, Timecode: "00:01:59;01"},
+		{Rate: rate.F29_97Df, Frames: 3597, Timecode: "00:01:59;29"},
+		{Rate: rate.F29_97Df, Frames: 3598, Timecode: "00:02:00;02"},
+		{Rate: rate.F29_97Df, Frames: 5395, Timecode: "00:02:59;29"},
+		{Rate: rate.F29_97Df, Frames: 5396, Timecode: "00:03:00;02"},
+		{Rate: rate.F29_97Df, Frames: 15000, Timecode: "00:08:20;16"},
+		{Rate: rate.F29_97Df, Frames: 17981, Timecode: "00:09:59;29"},
+		{Rate: rate.F29_97Df, Frames: 17982, Timecode: "00:10:00;00"},
+		{Rate: rate.F29_97Df, Frames: 17983, Timecode: "00:10:00;01"},
+		{Rate: rate.F29_97Df, Frames: 19781, Timecode: "00:10:59;29"},
+		{Rate: rate.F29_97Df, Frames: 19782, Timecode: "00:11:00;02"},
+		{Rate: rate.F59_94Df, Frames: 3599, Timecode: "00:00:59;59"},
+		{Rate: rate.F59_94Df, Frames: 3600, Timecode: "00:01:00;04"},
+		{Rate: rate.F59_94Df, Frames: 7136, Timecode: "00:01:59;00"},
+		{Rate: rate.F59_94Df, Frames: 7195, Timecode: "00:01:59;59"},
+		{Rate: rate.F59_94Df, Frames: 7196, Timecode: "00:02:00;04"},
+		{Rate: rate.F59_94Df, Frames: 35964, Timecode: "00:10:00;00"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Timecode+" "+testCase.Rate.String(), func(t *testing.T) {
+			assert := assert.New(t)
+
+			timecode := tc.FromFrames(testCase.Frames, testCase.Rate)
+			assert.Equal(testCase.Timecode, timecode.Timecode(), "timecode")
+			assert.Equal(testCase.Frames, timecode.Frames(), "frames")
+
+			parsed, err := tc.FromTimecode(testCase.Timecode, testCase.Rate)
+			if assert.NoError(err, "parse") {
+				assert.Equal(testCase.Frames, parsed.Frames(), "parsed frames")
+			}
+		})
+	}
+}
+
+// TestDropFrame_ParseDroppedFrames checks that only the frame numbers skipped at the
+// start of a minute are rejected when parsing drop-frame timecode.
+func TestDropFrame_ParseDroppedFrames(t *testing.T) {
+	cases := []struct {
+		Timecode string
+		Frames   int64
+		Err      error
+	}{
+		{Timecode: "00:01:00;00", Err: tc.ErrBadDropFrameValue},
+		{Timecode: "00:01:00;01", Err: tc.ErrBadDropFrameValue},
+		{Timecode: "00:01:00;02", Frames: 1800},
+		{Timecode: "00:01:01;00", Frames: 1828},
+		{Timecode: "00:01:01;01", Frames: 1829},
+		{Timecode: "00:01:30;00", Frames: 2698},
+		{Timecode: "00:10:00;00", Frames: 17982},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Timecode, func(t *testing.T) {
+			timecode, err := tc.FromTimecode(testCase.Timecode, rate.F29_97Df)
+			if testCase.Err != nil {
+				assert.True(t, errors.Is(err, testCase.Err), "error is %v: %v", testCase.Err, err)
+				return
+			}
+
+			if assert.NoError(t, err, "parse") {
+				assert.Equal(t, testCase.Frames, timecode.Frames(), "frames")
+				assert.Equal(t, testCase.Timecode, timecode.Timecode(), "timecode")
+			}
+		})
+	}
+}
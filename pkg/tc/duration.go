@@ -0,0 +1,180 @@
+package tc
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/internal"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/wadey/go-rounding"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+)
+
+/*
+Duration returns the real-world length of the timecode as a time.Duration, rounded to a
+whole nanosecond using mode.
+
+Precision
+
+The nanoseconds are worked out from the exact rational Seconds in a single step, so the
+rounding error is never more than one nanosecond, however long the timecode is. Most
+NTSC frames do not land on a whole nanosecond: a single frame at 23.98 NTSC lasts
+41708333⅓ nanoseconds. Use RoundExact to get ErrInexactDuration rather than a rounded
+value.
+
+ErrDurationOverflow is returned if the timecode is longer than about 292 years, which
+is the longest value a time.Duration can hold.
+
+Note: this method will panic if mode is not one of the Rounding values.
+*/
+func (tc Timecode) Duration(mode Rounding) (time.Duration, error) {
+	nanoseconds := tc.Seconds()
+	nanoseconds.Mul(nanoseconds, nanosecondsPerSecondRat)
+
+	nanoseconds, ok := mode.round(nanoseconds)
+	if !ok {
+		return 0, fmt.Errorf(
+			"%w: %v is %v nanoseconds", ErrInexactDuration, tc, nanoseconds.RatString(),
+		)
+	}
+
+	if !nanoseconds.Num().IsInt64() {
+		return 0, fmt.Errorf("%w: %v", ErrDurationOverflow, tc)
+	}
+
+	return time.Duration(nanoseconds.Num().Int64()), nil
+}
+
+// FromDuration creates a new Timecode from a time.Duration, rounded to the nearest
+// frame.
+func FromDuration(duration time.Duration, framerate rate.Framerate) Timecode {
+	return FromSeconds(big.NewRat(int64(duration), nanosecondsPerSecond), framerate)
+}
+
+// isoSecondsPrecision is the number of decimal places ISO8601 rounds seconds to, which
+// is a whole nanosecond.
+const isoSecondsPrecision = 9
+
+/*
+ISO8601 returns the real-world length of the timecode as an ISO 8601 duration, like
+'PT1H0M3.6S'.
+
+The hours, minutes and seconds places are always written, as MPEG-DASH manifests do, and
+hours do not roll over into days. Negative values are written with a leading '-', as
+allowed by ISO 8601-2.
+
+Precision
+
+Seconds are rounded half-up to the nearest nanosecond, and written without trailing
+zeros. Most NTSC frames do not land on a whole nanosecond: a single frame at 23.98 NTSC
+lasts 41708333⅓ nanoseconds, so it is written as 'PT0H0M0.041708333S'. The rounding
+error is never more than half a nanosecond, so FromISO8601 parses the result back to the
+same frame.
+
+Where you see it
+
+• MPEG-DASH manifests, like the mediaPresentationDuration attribute.
+
+• Broadcast schedules and REST APIs.
+*/
+func (tc Timecode) ISO8601() string {
+	seconds := tc.Seconds()
+	// If this is a negative value, make it positive for the purposes of splitting the
+	// value.
+	isNegative := tc.IsNegative()
+	if isNegative {
+		seconds.Neg(seconds)
+	}
+
+	// Round before splitting the places so that rounding up can carry into the minutes
+	// and hours.
+	seconds = rounding.Round(seconds, isoSecondsPrecision, rounding.HalfUp)
+	hours, seconds := internal.DivModRat(seconds, secondsPerHourRat)
+	minutes, seconds := internal.DivModRat(seconds, secondsPerMinuteRat)
+
+	secondsStr := seconds.FloatString(isoSecondsPrecision)
+	// Trim any trailing zeros, and the decimal mark if nothing is left after it.
+	secondsStr = strings.TrimRight(strings.TrimRight(secondsStr, "0"), ".")
+
+	sign := ""
+	if isNegative {
+		sign = "-"
+	}
+
+	return fmt.Sprintf("%vPT%vH%vM%vS", sign, hours.Num(), minutes.Num(), secondsStr)
+}
+
+// isoNumber matches the value of a place in an ISO 8601 duration, which may use either
+// a '.' or ',' decimal mark.
+const isoNumber = `([0-9]+(?:[.,][0-9]+)?)`
+
+// isoDurationRegex parses ISO 8601 durations.
+var isoDurationRegex = regexp.MustCompile(
+	`^([-+])?P(?:` + isoNumber + `D)?(T(?:` + isoNumber + `H)?(?:` + isoNumber + `M)?(?:` +
+		isoNumber + `S)?)?$`,
+)
+
+// Indexes of the submatch groups of isoDurationRegex.
+const (
+	isoRegexSign    = 1
+	isoRegexDays    = 2
+	isoRegexTime    = 3
+	isoRegexHours   = 4
+	isoRegexMinutes = 5
+	isoRegexSeconds = 6
+)
+
+// secondsPerDayRat is the number of seconds in an ISO 8601 day.
+var secondsPerDayRat = big.NewRat(secondsPerHour*hoursPerDay, 1)
+
+// FromISO8601 parses an ISO 8601 duration, like 'PT1H0M3.6S' or 'P1DT12H', rounded to
+// the nearest frame. Any place may be decimal, and a day is taken to be 24 hours.
+//
+// Years, months and weeks are not supported, since years and months do not have a fixed
+// length.
+//
+// Errors are returned as a *ParseError.
+func FromISO8601(value string, framerate rate.Framerate) (Timecode, error) {
+	match := isoDurationRegex.FindStringSubmatch(value)
+
+	// Every place is optional, so the regex will match 'P' and 'PT', which are not
+	// valid.
+	isEmpty := true
+	if match != nil {
+		isEmpty = match[isoRegexDays] == "" && match[isoRegexTime] == "" ||
+			match[isoRegexTime] == "T"
+	}
+
+	if isEmpty {
+		err := notRecognized(
+			"expected an ISO 8601 duration like '%v' without years, months or weeks",
+			exampleTimecode.ISO8601(),
+		)
+		return Timecode{}, withInput(err, value, FormatISO8601, nil)
+	}
+
+	seconds := new(big.Rat)
+	for _, place := range []struct {
+		group int
+		scale *big.Rat
+	}{
+		{isoRegexDays, secondsPerDayRat},
+		{isoRegexHours, secondsPerHourRat},
+		{isoRegexMinutes, secondsPerMinuteRat},
+		{isoRegexSeconds, secondsPerSecondRat},
+	} {
+		if match[place.group] == "" {
+			continue
+		}
+		// The regex only matches valid decimals, so we don't need to check.
+		placeValue, _ := new(big.Rat).SetString(strings.Replace(match[place.group], ",", ".", 1))
+		seconds.Add(seconds, placeValue.Mul(placeValue, place.scale))
+	}
+
+	if match[isoRegexSign] == "-" {
+		seconds.Neg(seconds)
+	}
+
+	return FromSeconds(seconds, framerate), nil
+}
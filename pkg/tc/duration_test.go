@@ -0,0 +1,185 @@
+package tc_test
+
+import (
+	"errors"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTimecode_Duration(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Timecode tc.Timecode
+		Mode     tc.Rounding
+		Expected time.Duration
+		Err      error
+	}{
+		{
+			Name:     "24 fps exact",
+			Timecode: tc.FromFrames(86400+12, rate.F24),
+			Mode:     tc.RoundExact,
+			Expected: time.Hour + 500*time.Millisecond,
+		},
+		{
+			Name:     "23.98 hour exact",
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			Mode:     tc.RoundExact,
+			Expected: time.Hour + 3600*time.Millisecond,
+		},
+		{
+			Name:     "23.98 frame nearest",
+			Timecode: tc.FromFrames(1, rate.F23_98),
+			Mode:     tc.RoundNearest,
+			Expected: 41708333 * time.Nanosecond,
+		},
+		{
+			Name:     "23.98 two frames nearest",
+			Timecode: tc.FromFrames(2, rate.F23_98),
+			Mode:     tc.RoundNearest,
+			Expected: 83416667 * time.Nanosecond,
+		},
+		{
+			Name:     "23.98 two frames truncate",
+			Timecode: tc.FromFrames(2, rate.F23_98),
+			Mode:     tc.RoundTruncate,
+			Expected: 83416666 * time.Nanosecond,
+		},
+		{
+			Name:     "23.98 negative truncate",
+			Timecode: tc.FromFrames(-2, rate.F23_98),
+			Mode:     tc.RoundTruncate,
+			Expected: -83416666 * time.Nanosecond,
+		},
+		{
+			Name:     "23.98 frame exact",
+			Timecode: tc.FromFrames(1, rate.F23_98),
+			Mode:     tc.RoundExact,
+			Err:      tc.ErrInexactDuration,
+		},
+		{
+			Name:     "long 29.97 DF nearest",
+			Timecode: tc.FromFrames(30000*24*365*100+1, rate.F29_97Df),
+			Mode:     tc.RoundNearest,
+			Expected: 876876000033366667 * time.Nanosecond,
+		},
+		{
+			Name:     "overflow",
+			Timecode: tc.FromFrames(math.MaxInt64/24, rate.F24),
+			Mode:     tc.RoundNearest,
+			Err:      tc.ErrDurationOverflow,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			duration, err := testCase.Timecode.Duration(testCase.Mode)
+			if testCase.Err != nil {
+				assert.True(t, errors.Is(err, testCase.Err), "error is %v: %v", testCase.Err, err)
+				return
+			}
+
+			if assert.NoError(t, err) {
+				assert.Equal(t, testCase.Expected, duration)
+			}
+		})
+	}
+
+	assert.Panics(t, func() { _, _ = tc.FromFrames(1, rate.F24).Duration(100) })
+}
+
+func TestFromDuration(t *testing.T) {
+	assert.Equal(t, "01:00:00:00", tc.FromDuration(time.Hour+3600*time.Millisecond, rate.F23_98).Timecode())
+	assert.Equal(t, "00:00:00:01", tc.FromDuration(41708333, rate.F23_98).Timecode())
+	assert.Equal(t, "-00:00:00:12", tc.FromDuration(-500*time.Millisecond, rate.F24).Timecode())
+
+	// Every frame should survive a round trip through a time.Duration.
+	for frame := int64(-100); frame < 86400*10; frame += 997 {
+		timecode := tc.FromFrames(frame, rate.F29_97Df)
+		duration, err := timecode.Duration(tc.RoundNearest)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		assert.Equal(t, frame, tc.FromDuration(duration, rate.F29_97Df).Frames(), "frame %v", frame)
+	}
+}
+
+func TestTimecode_ISO8601(t *testing.T) {
+	cases := []struct {
+		Timecode string
+		Rate     rate.Framerate
+		Expected string
+	}{
+		{Timecode: "01:00:00:00", Rate: rate.F23_98, Expected: "PT1H0M3.6S"},
+		{Timecode: "01:00:00:00", Rate: rate.F24, Expected: "PT1H0M0S"},
+		{Timecode: "00:00:00:00", Rate: rate.F24, Expected: "PT0H0M0S"},
+		{Timecode: "00:10:54:12", Rate: rate.F24, Expected: "PT0H10M54.5S"},
+		{Timecode: "00:00:00:01", Rate: rate.F23_98, Expected: "PT0H0M0.041708333S"},
+		{Timecode: "00:00:00:02", Rate: rate.F29_97Df, Expected: "PT0H0M0.066733333S"},
+		{Timecode: "00:00:00:01", Rate: rate.F59_94Ndf, Expected: "PT0H0M0.016683333S"},
+		{Timecode: "00:00:00:02", Rate: rate.F23_98, Expected: "PT0H0M0.083416667S"},
+		{Timecode: "25:00:00:00", Rate: rate.F24, Expected: "PT25H0M0S"},
+		{Timecode: "-00:00:01:12", Rate: rate.F24, Expected: "-PT0H0M1.5S"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Expected, func(t *testing.T) {
+			timecode, err := tc.FromTimecode(testCase.Timecode, testCase.Rate)
+			if !assert.NoError(t, err, "parse timecode") {
+				t.FailNow()
+			}
+
+			iso := timecode.ISO8601()
+			assert.Equal(t, testCase.Expected, iso, "iso")
+
+			parsed, err := tc.FromISO8601(iso, testCase.Rate)
+			if assert.NoError(t, err, "parse iso") {
+				assert.Equal(t, timecode.Seconds(), parsed.Seconds(), "round trip seconds")
+			}
+		})
+	}
+}
+
+func TestFromISO8601(t *testing.T) {
+	cases := []struct {
+		In       string
+		Expected string
+	}{
+		{In: "PT1H0M3.6S", Expected: "01:00:00:00"},
+		{In: "PT1H3.6S", Expected: "01:00:00:00"},
+		{In: "PT3603,6S", Expected: "01:00:00:00"},
+		{In: "PT60.06M", Expected: "01:00:00:00"},
+		{In: "P1D", Expected: "23:58:33:16"},
+		{In: "P1DT1H", Expected: "24:58:30:02"},
+		{In: "+PT3.6S", Expected: "00:00:03:14"},
+		{In: "-PT3.6S", Expected: "-00:00:03:14"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.In, func(t *testing.T) {
+			parsed, err := tc.FromISO8601(testCase.In, rate.F23_98)
+			if assert.NoError(t, err, "parse") {
+				assert.Equal(t, testCase.Expected, parsed.Timecode(), "timecode")
+			}
+		})
+	}
+}
+
+func TestFromISO8601_Errors(t *testing.T) {
+	cases := []string{"", "P", "PT", "P1DT", "P1Y", "P1M", "P2W", "PT1S1M", "1H", "PT.5S", "pt1h"}
+
+	for _, testCase := range cases {
+		t.Run(testCase, func(t *testing.T) {
+			_, err := tc.FromISO8601(testCase, rate.F24)
+			assert.ErrorIs(t, err, tc.ErrFormatNotRecognized, "is correct sub err")
+
+			parseErr, ok := err.(*tc.ParseError)
+			if assert.True(t, ok, "is ParseError") {
+				assert.Equal(t, tc.FormatISO8601, parseErr.Format, "format")
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package tc
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"math/big"
+	"strings"
+)
+
+// MarshalText implements encoding.TextMarshaler, encoding the value returned by
+// Rollover.String.
+func (rollover Rollover) MarshalText() ([]byte, error) {
+	if rollover < RolloverNone || rollover > RolloverDays {
+		return nil, ErrBadRollover
+	}
+	return []byte(rollover.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding the values written by
+// MarshalText.
+func (rollover *Rollover) UnmarshalText(text []byte) error {
+	for _, value := range []Rollover{RolloverNone, Rollover24h, RolloverDays} {
+		if string(text) == value.String() {
+			*rollover = value
+			return nil
+		}
+	}
+	return ErrBadRollover
+}
+
+// rolloverSeparator separates the framerate and Rollover policy in the output of
+// Timecode.MarshalText.
+const rolloverSeparator = "; rollover "
+
+// MarshalText implements encoding.TextMarshaler. The Timecode is encoded losslessly as
+// its rational seconds and framerate, like '18018/5 @ 24000/1001 NTSC NDF'. See
+// rate.Framerate.MarshalText for the framerate format.
+//
+// Timecode values that do not use RolloverNone have their Rollover policy added, like
+// '90000 @ 24 fps; rollover days', so the text form holds the same values as
+// MarshalJSON. The zero value is encoded as an empty string.
+func (tc Timecode) MarshalText() ([]byte, error) {
+	if tc.seconds == nil {
+		return []byte{}, nil
+	}
+
+	rateText, err := tc.rate.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	text := tc.seconds.RatString() + rateSeparator + string(rateText)
+	if tc.rollover != RolloverNone {
+		rolloverText, err := tc.rollover.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		text += rolloverSeparator + string(rolloverText)
+	}
+
+	return []byte(text), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding the value written by
+// MarshalText. An empty string decodes to the zero value.
+//
+// Errors are returned as a *ParseError, or a *rate.ParseError if the framerate could not
+// be parsed.
+func (tc *Timecode) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*tc = Timecode{}
+		return nil
+	}
+
+	value := string(text)
+	rest := value
+
+	rollover := RolloverNone
+	if rolloverIndex := strings.LastIndex(rest, rolloverSeparator); rolloverIndex != -1 {
+		rolloverStart := rolloverIndex + len(rolloverSeparator)
+		if err := rollover.UnmarshalText([]byte(rest[rolloverStart:])); err != nil {
+			err = fieldError(err, fieldRollover, "must be '24h' or 'days'")
+			return withInput(err, value, FormatUnknown, map[string]int{fieldRollover: rolloverStart})
+		}
+		rest = rest[:rolloverIndex]
+	}
+
+	separatorIndex := strings.LastIndex(rest, rateSeparator)
+	if separatorIndex == -1 {
+		err := notRecognized(
+			"expected rational seconds and a framerate like '18018/5%v24000/1001 NTSC NDF'",
+			rateSeparator,
+		)
+		return withInput(err, value, FormatUnknown, nil)
+	}
+
+	framerate := rate.Framerate{}
+	if err := framerate.UnmarshalText([]byte(rest[separatorIndex+len(rateSeparator):])); err != nil {
+		return err
+	}
+
+	seconds, err := parseSeconds(rest[:separatorIndex])
+	if err != nil {
+		return withInput(err, value, FormatUnknown, map[string]int{fieldSeconds: 0})
+	}
+
+	*tc = Timecode{
+		seconds: seconds,
+		rate:    framerate,
+	}.WithRollover(rollover)
+	return nil
+}
+
+// parseSeconds parses a rational seconds value, like '18018/5'.
+func parseSeconds(value string) (*big.Rat, error) {
+	seconds, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fieldError(
+			ErrFormatNotRecognized, fieldSeconds, "seconds must be a rational like '18018/5'",
+		)
+	}
+	return seconds, nil
+}
+
+// jsonTimecode is the JSON representation of a Timecode. Seconds is set for the
+// lossless form, and TC for the form written by CompactTimecode.
+type jsonTimecode struct {
+	Seconds  string        `json:"seconds,omitempty"`
+	TC       string        `json:"tc,omitempty"`
+	Rate     string        `json:"rate"`
+	NTSC     rate.NTSC     `json:"ntsc"`
+	Scan     rate.ScanType `json:"scan,omitempty"`
+	Rollover Rollover      `json:"rollover,omitempty"`
+}
+
+// jsonNull is the JSON encoding of a nil value.
+var jsonNull = []byte("null")
+
+// MarshalJSON implements json.Marshaler. The Timecode is encoded losslessly, with its
+// seconds and framerate playback as rationals:
+//
+//	{"seconds":"18018/5","rate":"24000/1001","ntsc":"NDF"}
+//
+// A "scan" field is added for framerates with a rate.ScanType, and a "rollover" field
+// for Timecode values that do not use RolloverNone. Use CompactTimecode for a
+// human-readable form. The zero value is encoded as null.
+func (tc Timecode) MarshalJSON() ([]byte, error) {
+	if tc.seconds == nil {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(jsonTimecode{
+		Seconds:  tc.seconds.RatString(),
+		Rate:     tc.rate.Playback().RatString(),
+		NTSC:     tc.rate.NTSC(),
+		Scan:     tc.rate.ScanType(),
+		Rollover: tc.rollover,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both the form written by MarshalJSON and
+// the form written by CompactTimecode are accepted. null leaves the Timecode unchanged.
+//
+// Errors decoding the timecode are returned as a *ParseError, and errors decoding the
+// framerate as a *rate.ParseError.
+func (tc *Timecode) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+
+	decoded := jsonTimecode{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	framerate, err := rate.FromRatString(decoded.Rate, decoded.NTSC)
+	if err != nil {
+		return err
+	}
+	if framerate, err = framerate.WithScanType(decoded.Scan); err != nil {
+		return err
+	}
+
+	var timecode Timecode
+	switch {
+	case decoded.Seconds != "":
+		seconds, err := parseSeconds(decoded.Seconds)
+		if err != nil {
+			return withInput(err, decoded.Seconds, FormatUnknown, map[string]int{fieldSeconds: 0})
+		}
+		timecode = Timecode{seconds: seconds, rate: framerate}
+	case decoded.TC != "":
+		timecode, err = FromTimecode(decoded.TC, framerate)
+		if err != nil {
+			return err
+		}
+	default:
+		err := notRecognized("expected a 'seconds' or 'tc' field")
+		return withInput(err, string(data), FormatUnknown, nil)
+	}
+
+	*tc = timecode.WithRollover(decoded.Rollover)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder using the same lossless form as MarshalJSON.
+func (tc Timecode) GobEncode() ([]byte, error) {
+	return tc.MarshalJSON()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (tc *Timecode) GobDecode(data []byte) error {
+	return tc.UnmarshalJSON(data)
+}
+
+// CompactTimecode wraps a Timecode so it is marshaled to JSON in a compact,
+// human-readable form, with the timecode string in place of its rational seconds:
+//
+//	{"tc":"01:00:00:00","rate":"24000/1001","ntsc":"NDF"}
+//
+// Unlike Timecode.MarshalJSON, this form is rounded to the nearest whole frame. It can
+// be decoded by either CompactTimecode or Timecode.
+type CompactTimecode struct {
+	Timecode
+}
+
+// MarshalJSON implements json.Marshaler.
+func (compact CompactTimecode) MarshalJSON() ([]byte, error) {
+	if compact.seconds == nil {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(jsonTimecode{
+		TC:       compact.Timecode.Timecode(),
+		Rate:     compact.rate.Playback().RatString(),
+		NTSC:     compact.rate.NTSC(),
+		Scan:     compact.rate.ScanType(),
+		Rollover: compact.rollover,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. See Timecode.UnmarshalJSON.
+func (compact *CompactTimecode) UnmarshalJSON(data []byte) error {
+	return compact.Timecode.UnmarshalJSON(data)
+}
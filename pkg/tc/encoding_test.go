@@ -0,0 +1,290 @@
+package tc_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+// encodingCases are the Timecode values checked by the encoding round-trip tests.
+var encodingCases = []struct {
+	Name     string
+	Timecode tc.Timecode
+	Text     string
+	JSON     string
+	Compact  string
+}{
+	{
+		Name:     "23.98",
+		Timecode: tc.FromFrames(86400, rate.F23_98),
+		Text:     "18018/5 @ 24000/1001 NTSC NDF",
+		JSON:     `{"seconds":"18018/5","rate":"24000/1001","ntsc":"NDF"}`,
+		Compact:  `{"tc":"01:00:00:00","rate":"24000/1001","ntsc":"NDF"}`,
+	},
+	{
+		Name:     "29.97 DF Negative",
+		Timecode: tc.FromFrames(-1800, rate.F29_97Df),
+		Text:     "-3003/50 @ 30000/1001 NTSC DF",
+		JSON:     `{"seconds":"-3003/50","rate":"30000/1001","ntsc":"DF"}`,
+		Compact:  `{"tc":"-00:01:00;02","rate":"30000/1001","ntsc":"DF"}`,
+	},
+	{
+		Name:     "24 Subframes",
+		Timecode: tc.FromSubframes(2450, 100, rate.F24),
+		Text:     "49/48 @ 24 fps",
+		JSON:     `{"seconds":"49/48","rate":"24","ntsc":"none"}`,
+		Compact:  `{"tc":"00:00:01:01","rate":"24","ntsc":"none"}`,
+	},
+	{
+		Name:     "24 Days",
+		Timecode: tc.FromFrames(86400*25, rate.F24).WithRollover(tc.RolloverDays),
+		Text:     "90000 @ 24 fps; rollover days",
+		JSON:     `{"seconds":"90000","rate":"24","ntsc":"none","rollover":"days"}`,
+		Compact:  `{"tc":"1:01:00:00:00","rate":"24","ntsc":"none","rollover":"days"}`,
+	},
+	{
+		Name:     "24 24h",
+		Timecode: tc.FromFrames(-24, rate.F24).WithRollover(tc.Rollover24h),
+		Text:     "86399 @ 24 fps; rollover 24h",
+		JSON:     `{"seconds":"86399","rate":"24","ntsc":"none","rollover":"24h"}`,
+		Compact:  `{"tc":"23:59:59:00","rate":"24","ntsc":"none","rollover":"24h"}`,
+	},
+	{
+		Name:     "59.94i Fields",
+		Timecode: tc.FromFields(3, mustScan(rate.F29_97Ndf, rate.ScanInterlacedUpper)),
+		Text:     "1001/20000 @ 60000/1001i TFF NTSC NDF",
+		JSON:     `{"seconds":"1001/20000","rate":"30000/1001","ntsc":"NDF","scan":"tff"}`,
+		Compact:  `{"tc":"00:00:00:02","rate":"30000/1001","ntsc":"NDF","scan":"tff"}`,
+	},
+	{
+		Name:     "1/2 fps",
+		Timecode: tc.FromFrames(10, mustRatRate(1, 2)),
+		Text:     "20 @ 1/2 fps",
+		JSON:     `{"seconds":"20","rate":"1/2","ntsc":"none"}`,
+		Compact:  `{"tc":"00:00:20:00","rate":"1/2","ntsc":"none"}`,
+	},
+}
+
+// mustRatRate returns a non-NTSC framerate with a playback of num/denom, and panics on
+// an error.
+func mustRatRate(num int64, denom int64) rate.Framerate {
+	framerate, err := rate.FromRat(big.NewRat(num, denom), rate.NTSCNone)
+	if err != nil {
+		panic(err)
+	}
+	return framerate
+}
+
+// mustScan returns framerate with its ScanType set to scan, and panics on an error.
+func mustScan(framerate rate.Framerate, scan rate.ScanType) rate.Framerate {
+	framerate, err := framerate.WithScanType(scan)
+	if err != nil {
+		panic(err)
+	}
+	return framerate
+}
+
+func TestTimecode_Text(t *testing.T) {
+	for _, testCase := range encodingCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			text, err := testCase.Timecode.MarshalText()
+			if !assert.NoError(t, err, "marshal") {
+				t.FailNow()
+			}
+			assert.Equal(t, testCase.Text, string(text), "text")
+
+			decoded := tc.Timecode{}
+			if !assert.NoError(t, decoded.UnmarshalText(text), "unmarshal") {
+				t.FailNow()
+			}
+			assert.Equal(t, testCase.Timecode, decoded, "decoded")
+
+			// The text and JSON forms must hold the same values.
+			data, err := json.Marshal(testCase.Timecode)
+			if !assert.NoError(t, err, "marshal json") {
+				t.FailNow()
+			}
+
+			fromJSON := tc.Timecode{}
+			if assert.NoError(t, json.Unmarshal(data, &fromJSON), "unmarshal json") {
+				assert.Equal(t, fromJSON, decoded, "matches json")
+			}
+		})
+	}
+}
+
+func TestTimecode_JSON(t *testing.T) {
+	for _, testCase := range encodingCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			data, err := json.Marshal(testCase.Timecode)
+			if !assert.NoError(t, err, "marshal") {
+				t.FailNow()
+			}
+			assert.Equal(t, testCase.JSON, string(data), "json")
+
+			decoded := tc.Timecode{}
+			if !assert.NoError(t, json.Unmarshal(data, &decoded), "unmarshal") {
+				t.FailNow()
+			}
+			assert.Equal(t, testCase.Timecode, decoded, "decoded")
+		})
+	}
+}
+
+func TestCompactTimecode_JSON(t *testing.T) {
+	for _, testCase := range encodingCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			data, err := json.Marshal(tc.CompactTimecode{Timecode: testCase.Timecode})
+			if !assert.NoError(t, err, "marshal") {
+				t.FailNow()
+			}
+			assert.Equal(t, testCase.Compact, string(data), "json")
+
+			decoded := tc.CompactTimecode{}
+			if !assert.NoError(t, json.Unmarshal(data, &decoded), "unmarshal") {
+				t.FailNow()
+			}
+			assert.Equal(t, testCase.Timecode.Frames(), decoded.Frames(), "frames")
+			assert.Equal(t, testCase.Timecode.Rate(), decoded.Rate(), "rate")
+			assert.Equal(t, testCase.Timecode.Rollover(), decoded.Rollover(), "rollover")
+		})
+	}
+}
+
+func TestTimecode_Gob(t *testing.T) {
+	for _, testCase := range encodingCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			buffer := new(bytes.Buffer)
+			if !assert.NoError(t, gob.NewEncoder(buffer).Encode(testCase.Timecode), "encode") {
+				t.FailNow()
+			}
+
+			decoded := tc.Timecode{}
+			if !assert.NoError(t, gob.NewDecoder(buffer).Decode(&decoded), "decode") {
+				t.FailNow()
+			}
+			assert.Equal(t, testCase.Timecode, decoded, "decoded")
+		})
+	}
+}
+
+func TestTimecode_Encoding_Zero(t *testing.T) {
+	type document struct {
+		Timecode tc.Timecode `json:"timecode"`
+	}
+
+	data, err := json.Marshal(document{})
+	assert.NoError(t, err, "marshal json")
+	assert.Equal(t, `{"timecode":null}`, string(data))
+
+	decoded := document{}
+	assert.NoError(t, json.Unmarshal(data, &decoded), "unmarshal json")
+	assert.Equal(t, document{}, decoded)
+
+	text, err := tc.Timecode{}.MarshalText()
+	assert.NoError(t, err, "marshal text")
+	assert.Equal(t, "", string(text))
+}
+
+func TestTimecode_Encoding_Errors(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Decode func() error
+		Err    error
+	}{
+		{
+			Name: "Text Missing Rate",
+			Decode: func() error {
+				return new(tc.Timecode).UnmarshalText([]byte("18018/5"))
+			},
+			Err: tc.ErrFormatNotRecognized,
+		},
+		{
+			Name: "Text Bad Seconds",
+			Decode: func() error {
+				return new(tc.Timecode).UnmarshalText([]byte("1:00 @ 24 fps"))
+			},
+			Err: tc.ErrFormatNotRecognized,
+		},
+		{
+			Name: "Text Bad Rate",
+			Decode: func() error {
+				return new(tc.Timecode).UnmarshalText([]byte("18018/5 @ 24"))
+			},
+			Err: rate.ErrParseFramerate,
+		},
+		{
+			Name: "Text Bad Rollover",
+			Decode: func() error {
+				return new(tc.Timecode).UnmarshalText([]byte("90000 @ 24 fps; rollover weeks"))
+			},
+			Err: tc.ErrBadRollover,
+		},
+		{
+			Name: "JSON Missing Value",
+			Decode: func() error {
+				return json.Unmarshal([]byte(`{"rate":"24","ntsc":"none"}`), new(tc.Timecode))
+			},
+			Err: tc.ErrFormatNotRecognized,
+		},
+		{
+			Name: "JSON Bad Drop Frame",
+			Decode: func() error {
+				return json.Unmarshal(
+					[]byte(`{"tc":"00:01:00;00","rate":"30000/1001","ntsc":"DF"}`),
+					new(tc.Timecode),
+				)
+			},
+			Err: tc.ErrBadDropFrameValue,
+		},
+		{
+			Name: "JSON Bad NTSC",
+			Decode: func() error {
+				return json.Unmarshal(
+					[]byte(`{"seconds":"1","rate":"24","ntsc":"PAL"}`), new(tc.Timecode),
+				)
+			},
+			Err: rate.ErrBadNtsc,
+		},
+		{
+			Name: "JSON Bad Rollover",
+			Decode: func() error {
+				return json.Unmarshal(
+					[]byte(`{"seconds":"1","rate":"24","ntsc":"none","rollover":"weeks"}`),
+					new(tc.Timecode),
+				)
+			},
+			Err: tc.ErrBadRollover,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Decode()
+			assert.True(t, errors.Is(err, testCase.Err), "error is %v: %v", testCase.Err, err)
+		})
+	}
+}
+
+func TestTimecode_JSON_Lossless(t *testing.T) {
+	// Mixed-rate arithmetic can leave a Timecode between frames. That position should
+	// survive a round-trip.
+	timecode := tc.FromSeconds(big.NewRat(1, 3), rate.F24)
+	timecode = timecode.Add(tc.FromFrames(1, rate.F29_97Ndf))
+
+	data, err := json.Marshal(timecode)
+	if !assert.NoError(t, err, "marshal") {
+		t.FailNow()
+	}
+
+	decoded := tc.Timecode{}
+	if assert.NoError(t, json.Unmarshal(data, &decoded), "unmarshal") {
+		assert.Equal(t, timecode.Seconds(), decoded.Seconds())
+	}
+}
@@ -20,4 +20,87 @@ var (
 	ErrBadDropFrameValue = fmt.Errorf(
 		"%w: frames value not allowed in Drop-Frame timecode", ErrParseTimecode,
 	)
+
+	// ErrBadSubframeValue is returned when a timecode to be parsed includes a field or
+	// subframe suffix that is too large. Ex: ('01:00:00:00.2', since there are only two
+	// fields in a frame.)
+	ErrBadSubframeValue = fmt.Errorf(
+		"%w: field or subframe value overflows frame", ErrParseTimecode,
+	)
+
+	// ErrBadBCDValue is returned when a BCD time address word includes a digit that is
+	// over 9, or a place that is out of range. Ex: (0x0000000A, since the frames units
+	// digit is 10, or 0x00006000, since the seconds place is 60.)
+	ErrBadBCDValue = fmt.Errorf("%w: BCD value out of range", ErrParseTimecode)
+
+	// ErrSectionOverflow is returned by strict parsing when a section of a timecode is
+	// too large for its place. Ex: ('00:00:00:30' at 24 fps, since the frames place
+	// should be < 24.)
+	ErrSectionOverflow = fmt.Errorf("%w: section value overflows its place", ErrParseTimecode)
+
+	// ErrMissingSections is returned by strict parsing when a timecode does not include
+	// all of its hours, minutes, seconds and frames places. Ex: ('01:00:00').
+	ErrMissingSections = fmt.Errorf("%w: timecode is missing sections", ErrParseTimecode)
+
+	// ErrDropSeparatorMismatch is returned by strict parsing when the separator before
+	// the frames place does not match the drop-frame status of the framerate. Ex:
+	// ('00:01:00:02' at 29.97 DF, which should be written as '00:01:00;02'.)
+	ErrDropSeparatorMismatch = fmt.Errorf(
+		"%w: frames separator does not match drop-frame status", ErrParseTimecode,
+	)
+
+	// ErrAmbiguousFormat is returned by Parse when a string is valid in more than one
+	// format, and there is no way to tell which the caller intended.
+	ErrAmbiguousFormat = fmt.Errorf("%w: string format is ambiguous", ErrParseTimecode)
+
+	// ErrParseTemplate is returned when a Template string contains an unknown or
+	// incomplete token.
+	ErrParseTemplate = errors.New("could not parse Template")
+
+	// ErrParseKeyKode is returned when a KeyKode could not be parsed. Ex:
+	// ('KJ 23 1234 5678+16', since a foot of 35mm 4-perf film only has 16 frames.)
+	ErrParseKeyKode = fmt.Errorf("%w: bad KeyKode value", ErrParseTimecode)
+
+	// ErrKeyKodeRoll is returned when two KeyKode values that are not from the same
+	// roll of film are compared.
+	ErrKeyKodeRoll = errors.New("KeyKode values are not from the same film roll")
+
+	// ErrBadPerfValue is returned when a feet+perfs value does not land on the first
+	// perf of a frame.
+	ErrBadPerfValue = fmt.Errorf(
+		"%w: perfs value does not land on the start of a frame", ErrParseTimecode,
+	)
+
+	// ErrBadFilmFormat is returned when an enum value outside the predefined FilmFormat
+	// constant values is used.
+	ErrBadFilmFormat = errors.New("FilmFormat value not recognized")
+
+	// ErrBadRollover is returned when an enum value outside the predefined Rollover
+	// constant values is encoded or decoded.
+	ErrBadRollover = errors.New("Rollover value not recognized")
+
+	// ErrDurationOverflow is returned when a Timecode is too long to be held by a
+	// time.Duration, which tops out at about 292 years.
+	ErrDurationOverflow = errors.New("Timecode overflows time.Duration")
+
+	// ErrInexactDuration is returned by Timecode.Duration with RoundExact when the
+	// Timecode does not land on a whole nanosecond. Ex: (a single frame at 23.98 NTSC,
+	// which lasts 41708333⅓ nanoseconds.)
+	ErrInexactDuration = errors.New("Timecode is not a whole number of nanoseconds")
+
+	// ErrBadTickBase is returned when a TickBase is not a positive length of time.
+	ErrBadTickBase = errors.New("TickBase must be positive")
+
+	// ErrInexactTicks is returned by Timecode.Ticks with RoundExact when the Timecode
+	// does not land on a whole tick. Ex: (a single frame at 23.98 NTSC, which lasts
+	// 3753.75 ticks of a 90 kHz clock.)
+	ErrInexactTicks = errors.New("Timecode is not a whole number of ticks")
+
+	// ErrTicksOverflow is returned by Timecode.Ticks when the number of ticks does not
+	// fit in an int64. Use Timecode.TicksBig for these values.
+	ErrTicksOverflow = errors.New("Timecode ticks overflow int64")
+
+	// ErrScanType is returned when a database value of an unsupported type is scanned
+	// into a Timecode.
+	ErrScanType = errors.New("unsupported Scan source type for Timecode")
 )
@@ -1,6 +1,8 @@
 package tc_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/opencinemac/vtc-go/pkg/rate"
 	"github.com/opencinemac/vtc-go/pkg/tc"
@@ -69,3 +71,217 @@ func ExampleFromTimecode_overflow() {
 	// Output:
 	// 00:00:02:00 @ 23.98 NTSC NDF
 }
+
+// Parse detects which format a string is in.
+func ExampleParse() {
+	for _, value := range []string{"01:00:00:00", "01:00:03.6", "5400+00", "86400"} {
+		timecode, format, _ := tc.Parse(value, rate.F23_98)
+		fmt.Printf("%v: %v (%v)\n", value, timecode.Timecode(), format)
+	}
+
+	// Output:
+	// 01:00:00:00: 01:00:00:00 (timecode)
+	// 01:00:03.6: 01:00:00:00 (runtime)
+	// 5400+00: 01:00:00:00 (feet and frames)
+	// 86400: 01:00:00:00 (frames)
+}
+
+// 35mm 3-perf film has 64 frames every 3 feet, so the number of frames in each foot
+// varies.
+func ExampleTimecode_FeetAndFramesFor() {
+	for _, frames := range []int64{21, 22, 43, 64} {
+		timecode := tc.FromFrames(frames, rate.F24)
+		fmt.Println(timecode.FeetAndFramesFor(tc.Film35mm3Perf), timecode.FeetAndPerfsFor(tc.Film35mm3Perf))
+	}
+
+	// Output:
+	// 0+21 0+63
+	// 1+00 1+02
+	// 2+00 2+01
+	// 3+00 3+00
+}
+
+// Interlaced fields and Pro Tools-style subframes can be parsed and formatted without
+// losing the exact position within the frame.
+func ExampleTimecode_TimecodeField() {
+	timecode, _ := tc.FromTimecode("01:00:00:00.1", rate.F29_97Ndf)
+	fmt.Println(timecode.TimecodeField(), timecode.Field())
+
+	timecode, _ = tc.FromTimecode("01:00:00:00+50", rate.F29_97Ndf)
+	fmt.Println(timecode.TimecodeSubframes(), timecode.Subframes(100))
+
+	// Output:
+	// 01:00:00:00.1 1
+	// 01:00:00:00+50 50
+}
+
+// FromTimecodeStrict rejects values that FromTimecode would normalize.
+func ExampleFromTimecodeStrict() {
+	_, err := tc.FromTimecodeStrict("00:00:00:48", rate.F23_98)
+	fmt.Println(err)
+
+	// Output:
+	// could not parse Timecode: section value overflows its place: frames field 48 must be less than timebase 24 (timecode "00:00:00:48", byte 9)
+}
+
+// ParseWithRate parses the output of Timecode.String.
+func ExampleParseWithRate() {
+	timecode, err := tc.ParseWithRate("01:00:00:00 @ 23.98 NTSC NDF")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(timecode.Frames(), timecode.Rate().Playback())
+
+	// Output:
+	// 86400 24000/1001
+}
+
+// Parsing errors are returned as a *tc.ParseError, which reports where in the input the
+// problem was found.
+func ExampleParseError() {
+	_, err := tc.FromTimecodeStrict("01:00:00:31", rate.F29_97Ndf)
+
+	var parseErr *tc.ParseError
+	if errors.As(err, &parseErr) {
+		fmt.Println("FIELD: ", parseErr.Field)
+		fmt.Println("OFFSET:", parseErr.Offset)
+		fmt.Println("REASON:", parseErr.Reason)
+	}
+
+	fmt.Println("IS OVERFLOW:", errors.Is(err, tc.ErrSectionOverflow))
+
+	// Output:
+	// FIELD:  frames
+	// OFFSET: 9
+	// REASON: frames field 31 must be less than timebase 30
+	// IS OVERFLOW: true
+}
+
+// Timecode implements fmt.Formatter, with a verb for each representation.
+func ExampleTimecode_Format() {
+	timecode := tc.FromFrames(86400, rate.F23_98)
+
+	fmt.Printf("%t | %.3r | %f | %08d\n", timecode, timecode, timecode, timecode)
+
+	// Output:
+	// 01:00:00:00 | 01:00:03.6 | 5400+00 | 00086400
+}
+
+// Templates can be used to build house styles.
+func ExampleTemplate() {
+	houseStyle := tc.MustParseTemplate("TC %Hh%Mm%Ss%Ff")
+
+	timecode, _ := tc.FromTimecode("01:02:03:04", rate.F24)
+	fmt.Println(houseStyle.Format(timecode))
+
+	// Output:
+	// TC 01h02m03s04f
+}
+
+// Notations format and parse timecode in other vendors' dialects.
+func ExampleNotation() {
+	timecode, _ := tc.NotationPeriods.FromTimecode("01.00.00.00", rate.F23_98)
+
+	fmt.Println(timecode.Timecode())
+	fmt.Println(tc.NotationUnits.Timecode(timecode))
+	fmt.Println(tc.NotationSRT.Runtime(timecode, 3))
+
+	// Output:
+	// 01:00:00:00
+	// 01h00m00s00i
+	// 01:00:03,6
+}
+
+// Timecode values can be stored in JSON documents losslessly, or in a compact form.
+func ExampleCompactTimecode() {
+	timecode := tc.FromFrames(86400, rate.F23_98)
+
+	lossless, _ := json.Marshal(timecode)
+	compact, _ := json.Marshal(tc.CompactTimecode{Timecode: timecode})
+
+	fmt.Println(string(lossless))
+	fmt.Println(string(compact))
+
+	// Output:
+	// {"seconds":"18018/5","rate":"24000/1001","ntsc":"NDF"}
+	// {"tc":"01:00:00:00","rate":"24000/1001","ntsc":"NDF"}
+}
+
+// Timecode values can be packed into the BCD time address words used by hardware.
+func ExampleTimecode_PackBCD() {
+	timecode, _ := tc.FromTimecode("01:00:00;02", rate.F29_97Df)
+
+	word := timecode.PackBCD()
+	fmt.Printf("%08X\n", word)
+
+	parsed, _ := tc.FromBCD(word, rate.F29_97Ndf)
+	fmt.Println(parsed)
+
+	// Output:
+	// 01000042
+	// 01:00:00;02 @ 29.97 NTSC DF
+}
+
+// Durations can be written and read the way producers type them.
+func ExampleTimecode_Human() {
+	timecode, _ := tc.FromHuman("62m 3.17s", rate.F24)
+
+	fmt.Println(timecode.Timecode())
+	fmt.Println(timecode.Human(tc.HumanFrames))
+	fmt.Println(timecode.Human(tc.HumanSeconds))
+
+	// Output:
+	// 01:02:03:04
+	// 1h 02m 03s 04f
+	// 3723.17s
+}
+
+// Timecode values can be passed to Go's standard time APIs and written as ISO 8601
+// durations.
+func ExampleTimecode_Duration() {
+	timecode := tc.FromFrames(86400, rate.F23_98)
+
+	duration, _ := timecode.Duration(tc.RoundNearest)
+	fmt.Println(duration)
+	fmt.Println(timecode.ISO8601())
+
+	_, err := tc.FromFrames(1, rate.F23_98).Duration(tc.RoundExact)
+	fmt.Println(err)
+
+	// Output:
+	// 1h0m3.6s
+	// PT1H0M3.6S
+	// Timecode is not a whole number of nanoseconds: 00:00:00:01 @ 23.98 NTSC NDF is 125125000/3 nanoseconds
+}
+
+// Timecode values can be converted to ticks of any clock, like an MPEG PTS.
+func ExampleTimecode_Ticks() {
+	timecode := tc.FromFrames(1, rate.F23_98)
+
+	pts, _ := timecode.Ticks(tc.TickBase90kHz, tc.RoundFloor)
+	fmt.Println(pts)
+
+	timeBase, _ := tc.ParseTickBase("1/24000")
+	ticks, _ := timecode.Ticks(timeBase, tc.RoundExact)
+	fmt.Println(ticks)
+
+	// Output:
+	// 3753
+	// 1001
+}
+
+// NTSC frames do not land on whole audio samples, so their length in samples follows a
+// repeating cadence.
+func ExampleSampleCadence() {
+	fmt.Println(tc.SamplesPerFrame(rate.F29_97Ndf, tc.SampleRate48k).FloatString(1))
+	fmt.Println(tc.SampleCadence(rate.F29_97Ndf, tc.SampleRate48k))
+
+	timecode, _ := tc.FromTimecode("01:00:00:00", rate.F29_97Ndf)
+	fmt.Println(timecode.Samples(tc.SampleRate48k))
+
+	// Output:
+	// 1601.6
+	// [1602 1601 1602 1601 1602]
+	// 172972800
+}
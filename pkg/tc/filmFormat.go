@@ -0,0 +1,115 @@
+package tc
+
+import "math/big"
+
+// FilmFormat is an enum-like type for specifying the gauge and pulldown of physical
+// film stock, which determines how many frames are in a foot.
+//
+// Film is measured by its perforations (perfs). Each format has a fixed number of
+// perfs in a foot, and a fixed number of perfs per frame. When the perfs per foot is
+// not cleanly divisible by the perfs per frame, like with 35mm 3-perf, a frame may
+// straddle two feet, and the number of frames in each foot will vary. In that case, a
+// frame belongs to the foot that its first perf is in.
+type FilmFormat int
+
+const (
+	// Film35mm4Perf is 35mm film with 4 perfs per frame, and 16 frames per foot. This
+	// is the most common format on Hollywood movies.
+	Film35mm4Perf FilmFormat = iota
+	// Film16mm is 16mm film with 1 perf per frame, and 40 frames per foot.
+	Film16mm
+	// Film35mm3Perf is 35mm film with 3 perfs per frame, and 64 frames every 3 feet.
+	// Feet hold 22, 21, then 21 frames in a repeating cadence.
+	Film35mm3Perf
+	// Film35mm2Perf is 35mm film with 2 perfs per frame, and 32 frames per foot.
+	Film35mm2Perf
+	// Film65mm5Perf is 65mm film with 5 perfs per frame, and 12.8 frames per foot.
+	Film65mm5Perf
+)
+
+// String implements fmt.Stringer.
+func (format FilmFormat) String() string {
+	switch format {
+	case Film35mm4Perf:
+		return "35mm 4-perf"
+	case Film16mm:
+		return "16mm"
+	case Film35mm3Perf:
+		return "35mm 3-perf"
+	case Film35mm2Perf:
+		return "35mm 2-perf"
+	case Film65mm5Perf:
+		return "65mm 5-perf"
+	default:
+		return "[INVALID FILM FORMAT]"
+	}
+}
+
+// Validate returns ErrBadFilmFormat if this value is not one of the pre-defined
+// FilmFormat constants that ships with this library.
+func (format FilmFormat) Validate() error {
+	if format < Film35mm4Perf || format > Film65mm5Perf {
+		return ErrBadFilmFormat
+	}
+	return nil
+}
+
+// PerfsPerFrame returns the number of perforations in a single frame.
+func (format FilmFormat) PerfsPerFrame() int64 {
+	switch format {
+	case Film16mm:
+		return 1
+	case Film35mm3Perf:
+		return 3
+	case Film35mm2Perf:
+		return 2
+	case Film65mm5Perf:
+		return 5
+	default:
+		return 4
+	}
+}
+
+// PerfsPerFoot returns the number of perforations in a foot of film.
+func (format FilmFormat) PerfsPerFoot() int64 {
+	if format == Film16mm {
+		return 40
+	}
+
+	// 35mm and 65mm share the same perforation pitch.
+	return 64
+}
+
+// FramesPerFoot returns the average number of frames in a foot of film. For formats
+// like 35mm 3-perf, this is not a whole number.
+func (format FilmFormat) FramesPerFoot() *big.Rat {
+	return big.NewRat(format.PerfsPerFoot(), format.PerfsPerFrame())
+}
+
+// firstFrameOfFoot returns the frame count of the first frame whose first perf is in
+// the given foot.
+func (format FilmFormat) firstFrameOfFoot(feet int64) int64 {
+	perfs := feet * format.PerfsPerFoot()
+	perFrame := format.PerfsPerFrame()
+
+	// Round up, so a frame that straddles the start of the foot is not counted in it.
+	return (perfs + perFrame - 1) / perFrame
+}
+
+// framesInFoot returns the number of frames that start in the given foot.
+func (format FilmFormat) framesInFoot(feet int64) int64 {
+	return format.firstFrameOfFoot(feet+1) - format.firstFrameOfFoot(feet)
+}
+
+// splitFeetAndFrames splits a positive frame count into the number of whole feet, and
+// the remaining frames into the next foot.
+func splitFeetAndFrames(frames int64, format FilmFormat) (feet int64, remainder int64) {
+	feet = frames * format.PerfsPerFrame() / format.PerfsPerFoot()
+	return feet, frames - format.firstFrameOfFoot(feet)
+}
+
+// joinFeetAndFrames is the inverse of splitFeetAndFrames, and returns the total frame
+// count of a feet and frames value.
+func joinFeetAndFrames(feet int64, frames int64, format FilmFormat) int64 {
+	return format.firstFrameOfFoot(feet) + frames
+}
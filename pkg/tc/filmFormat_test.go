@@ -0,0 +1,119 @@
+package tc_test
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestFeetAndFramesFor(t *testing.T) {
+	cases := []struct {
+		Format        tc.FilmFormat
+		Frames        int64
+		FeetAndFrames string
+		FeetAndPerfs  string
+	}{
+		{Format: tc.Film35mm4Perf, Frames: 86400, FeetAndFrames: "5400+00", FeetAndPerfs: "5400+00"},
+		{Format: tc.Film35mm4Perf, Frames: 17, FeetAndFrames: "1+01", FeetAndPerfs: "1+04"},
+		{Format: tc.Film16mm, Frames: 41, FeetAndFrames: "1+01", FeetAndPerfs: "1+01"},
+		{Format: tc.Film35mm2Perf, Frames: 33, FeetAndFrames: "1+01", FeetAndPerfs: "1+02"},
+		{Format: tc.Film35mm3Perf, Frames: 21, FeetAndFrames: "0+21", FeetAndPerfs: "0+63"},
+		{Format: tc.Film35mm3Perf, Frames: 22, FeetAndFrames: "1+00", FeetAndPerfs: "1+02"},
+		{Format: tc.Film35mm3Perf, Frames: 43, FeetAndFrames: "2+00", FeetAndPerfs: "2+01"},
+		{Format: tc.Film35mm3Perf, Frames: 63, FeetAndFrames: "2+20", FeetAndPerfs: "2+61"},
+		{Format: tc.Film35mm3Perf, Frames: 64, FeetAndFrames: "3+00", FeetAndPerfs: "3+00"},
+		{Format: tc.Film65mm5Perf, Frames: 12, FeetAndFrames: "0+12", FeetAndPerfs: "0+60"},
+		{Format: tc.Film65mm5Perf, Frames: 13, FeetAndFrames: "1+00", FeetAndPerfs: "1+01"},
+		{Format: tc.Film65mm5Perf, Frames: 64, FeetAndFrames: "5+00", FeetAndPerfs: "5+00"},
+	}
+
+	for _, testCase := range cases {
+		name := fmt.Sprintf("%v %v", testCase.Format, testCase.Frames)
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			for _, frames := range []int64{testCase.Frames, -testCase.Frames} {
+				timecode := tc.FromFrames(frames, rate.F24)
+
+				sign := ""
+				if frames < 0 {
+					sign = "-"
+				}
+
+				assert.Equal(sign+testCase.FeetAndFrames, timecode.FeetAndFramesFor(testCase.Format), "feet and frames")
+				assert.Equal(sign+testCase.FeetAndPerfs, timecode.FeetAndPerfsFor(testCase.Format), "feet and perfs")
+				assert.Equal(frames*testCase.Format.PerfsPerFrame(), timecode.Perfs(testCase.Format), "perfs")
+
+				parsed, err := tc.FromFeetAndFramesFor(sign+testCase.FeetAndFrames, testCase.Format, rate.F24)
+				if assert.NoError(err, "parse feet and frames") {
+					assert.Equal(frames, parsed.Frames(), "parsed feet and frames")
+				}
+
+				parsed, err = tc.FromFeetAndPerfsFor(sign+testCase.FeetAndPerfs, testCase.Format, rate.F24)
+				if assert.NoError(err, "parse feet and perfs") {
+					assert.Equal(frames, parsed.Frames(), "parsed feet and perfs")
+				}
+			}
+		})
+	}
+}
+
+// TestFeetAndFramesFor_3PerfRoundTrip checks that every frame of a 35mm 3-perf reel
+// round-trips through feet+frames and feet+perfs.
+func TestFeetAndFramesFor_3PerfRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	for frames := int64(0); frames < 2000; frames++ {
+		timecode := tc.FromFrames(frames, rate.F23_98)
+
+		parsed, err := tc.FromFeetAndFramesFor(timecode.FeetAndFramesFor(tc.Film35mm3Perf), tc.Film35mm3Perf, rate.F23_98)
+		if !assert.NoError(err) || !assert.Equal(frames, parsed.Frames(), "feet and frames") {
+			t.FailNow()
+		}
+
+		parsed, err = tc.FromFeetAndPerfsFor(timecode.FeetAndPerfsFor(tc.Film35mm3Perf), tc.Film35mm3Perf, rate.F23_98)
+		if !assert.NoError(err) || !assert.Equal(frames, parsed.Frames(), "feet and perfs") {
+			t.FailNow()
+		}
+	}
+}
+
+func TestFromFeetAndPerfsFor_ErrBadPerf(t *testing.T) {
+	_, err := tc.FromFeetAndPerfsFor("1+01", tc.Film35mm3Perf, rate.F24)
+	assert.ErrorIs(t, err, tc.ErrParseTimecode, "is parse err")
+	assert.ErrorIs(t, err, tc.ErrBadPerfValue, "is correct sub err")
+}
+
+func TestFromFeetAndFramesFor_ErrBadFormat(t *testing.T) {
+	_, err := tc.FromFeetAndFramesFor("1+01", tc.FilmFormat(100), rate.F24)
+	assert.ErrorIs(t, err, tc.ErrBadFilmFormat)
+}
+
+func TestFromPerfs(t *testing.T) {
+	cases := []struct {
+		Perfs    int64
+		Expected int64
+	}{
+		{Perfs: 0, Expected: 0},
+		{Perfs: 4, Expected: 1},
+		{Perfs: 5, Expected: 1},
+		{Perfs: -4, Expected: -1},
+		{Perfs: -5, Expected: -2},
+	}
+
+	for _, testCase := range cases {
+		t.Run(fmt.Sprint(testCase.Perfs), func(t *testing.T) {
+			timecode := tc.FromPerfs(testCase.Perfs, tc.Film35mm4Perf, rate.F24)
+			assert.Equal(t, testCase.Expected, timecode.Frames())
+		})
+	}
+}
+
+func TestFilmFormat_FramesPerFoot(t *testing.T) {
+	assert.Equal(t, big.NewRat(64, 3), tc.Film35mm3Perf.FramesPerFoot())
+	assert.Equal(t, big.NewRat(64, 5), tc.Film65mm5Perf.FramesPerFoot())
+	assert.Equal(t, big.NewRat(40, 1), tc.Film16mm.FramesPerFoot())
+}
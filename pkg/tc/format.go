@@ -0,0 +1,97 @@
+package tc
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// defaultRuntimePrecision is the number of decimal places used by the %r verb when no
+// precision is given.
+const defaultRuntimePrecision = 9
+
+/*
+Format implements fmt.Formatter, so each representation of a Timecode can be picked with
+a fmt verb:
+
+• %v, %s: the String value (ex: 01:00:00:00 @ 23.98 NTSC NDF).
+
+• %t: the Timecode value (ex: 01:00:00:00).
+
+• %r: the Runtime value. The precision sets the number of decimal places, and defaults
+to 9 (ex: %.3r -> 01:00:03.6).
+
+• %f: the FeetAndFrames value (ex: 5400+00).
+
+• %d: the Frames value. Integer flags like zero-padding are respected (ex: %08d ->
+00086400).
+
+• %k: the PremiereTicks value.
+
+Width and the '-' flag pad the result of every verb. %q quotes the String value, and
+%#v prints the Go representation of the struct.
+*/
+func (tc Timecode) Format(state fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if state.Flag('#') {
+			// Write the fields ourselves, since formatting a copy of the struct without
+			// our methods would print the name of the copy's type.
+			fmt.Fprintf(
+				state,
+				"tc.Timecode{seconds:%#v, rate:%#v, rollover:%#v}",
+				tc.seconds,
+				tc.rate,
+				tc.rollover,
+			)
+			return
+		}
+		fmt.Fprintf(state, formatDirective(state, 's'), tc.String())
+	case 's', 'q':
+		fmt.Fprintf(state, formatDirective(state, verb), tc.String())
+	case 't':
+		fmt.Fprintf(state, formatDirective(state, 's'), tc.Timecode())
+	case 'r':
+		precision, ok := state.Precision()
+		if !ok {
+			precision = defaultRuntimePrecision
+		}
+		fmt.Fprintf(state, formatWidthDirective(state, 's'), tc.Runtime(precision))
+	case 'f':
+		fmt.Fprintf(state, formatDirective(state, 's'), tc.FeetAndFrames())
+	case 'd':
+		fmt.Fprintf(state, formatDirective(state, 'd'), tc.Frames())
+	case 'k':
+		fmt.Fprintf(state, formatDirective(state, 'd'), tc.PremiereTicks())
+	default:
+		fmt.Fprintf(state, "%%!%c(tc.Timecode=%v)", verb, tc.String())
+	}
+}
+
+// formatDirective rebuilds the fmt directive held by state, using verb.
+func formatDirective(state fmt.State, verb rune) string {
+	directive := formatWidthDirective(state, 0)
+	if precision, ok := state.Precision(); ok {
+		directive += "." + strconv.Itoa(precision)
+	}
+	return directive + string(verb)
+}
+
+// formatWidthDirective rebuilds the flags and width of the fmt directive held by
+// state, using verb. If verb is 0, it is left off.
+func formatWidthDirective(state fmt.State, verb rune) string {
+	directive := "%"
+	for _, flag := range "+-# 0" {
+		if state.Flag(int(flag)) {
+			directive += string(flag)
+		}
+	}
+
+	if width, ok := state.Width(); ok {
+		directive += strconv.Itoa(width)
+	}
+
+	if verb != 0 {
+		directive += string(verb)
+	}
+	return directive
+}
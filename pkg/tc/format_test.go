@@ -0,0 +1,122 @@
+package tc_test
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestTimecode_Format(t *testing.T) {
+	timecode := tc.FromFrames(86400, rate.F23_98)
+
+	cases := []struct {
+		Format   string
+		Expected string
+	}{
+		{Format: "%v", Expected: "01:00:00:00 @ 23.98 NTSC NDF"},
+		{Format: "%s", Expected: "01:00:00:00 @ 23.98 NTSC NDF"},
+		{Format: "%q", Expected: `"01:00:00:00 @ 23.98 NTSC NDF"`},
+		{Format: "%t", Expected: "01:00:00:00"},
+		{Format: "%14t", Expected: "   01:00:00:00"},
+		{Format: "%-14t|", Expected: "01:00:00:00   |"},
+		{Format: "%r", Expected: "01:00:03.6"},
+		{Format: "%.0r", Expected: "01:00:04.0"},
+		{Format: "%12.2r", Expected: "  01:00:03.6"},
+		{Format: "%f", Expected: "5400+00"},
+		{Format: "%d", Expected: "86400"},
+		{Format: "%08d", Expected: "00086400"},
+		{Format: "%+d", Expected: "+86400"},
+		{Format: "%k", Expected: "915372057600000"},
+		{Format: "%x", Expected: "%!x(tc.Timecode=01:00:00:00 @ 23.98 NTSC NDF)"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Format, func(t *testing.T) {
+			assert.Equal(t, testCase.Expected, fmt.Sprintf(testCase.Format, timecode))
+		})
+	}
+}
+
+func TestTimecode_Format_GoSyntax(t *testing.T) {
+	formatted := fmt.Sprintf("%#v", tc.FromFrames(24, rate.F24))
+	assert.True(t, strings.HasPrefix(formatted, "tc.Timecode{"), formatted)
+}
+
+func TestTemplate(t *testing.T) {
+	cases := []struct {
+		Template string
+		Timecode tc.Timecode
+		Expected string
+	}{
+		{
+			Template: "%-%H:%M:%S%;%F",
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			Expected: "01:00:00:00",
+		},
+		{
+			Template: "%-%H:%M:%S%;%F",
+			Timecode: tc.FromFrames(-1800, rate.F29_97Df),
+			Expected: "-00:01:00;02",
+		},
+		{
+			Template: "TC %Hh%Mm%Ss%Ff",
+			Timecode: tc.FromFrames(86400+24*62+3, rate.F24),
+			Expected: "TC 01h01m02s03f",
+		},
+		{
+			Template: "%Dd %H:%M:%S:%F",
+			Timecode: tc.FromFrames(86400*25, rate.F24).WithRollover(tc.RolloverDays),
+			Expected: "1d 01:00:00:00",
+		},
+		{
+			Template: "frame %n{8}, %n",
+			Timecode: tc.FromFrames(86400, rate.F24),
+			Expected: "frame 00086400, 86400",
+		},
+		{
+			Template: "%r / %r{2} / %r{0}",
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			Expected: "01:00:03.6 / 01:00:03.6 / 01:00:04.0",
+		},
+		{
+			Template: "[%t] %ff %k 100%%",
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			Expected: "[01:00:00:00] 5400+00 915372057600000 100%",
+		},
+		{
+			Template: "",
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			Expected: "",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Template, func(t *testing.T) {
+			assert := assert.New(t)
+
+			formatted, err := testCase.Timecode.FormatTemplate(testCase.Template)
+			if !assert.NoError(err, "format template") {
+				t.FailNow()
+			}
+			assert.Equal(testCase.Expected, formatted, "formatted")
+
+			tmpl := tc.MustParseTemplate(testCase.Template)
+			assert.Equal(testCase.Expected, tmpl.Format(testCase.Timecode), "template")
+			assert.Equal(testCase.Template, tmpl.String(), "source")
+		})
+	}
+}
+
+func TestParseTemplate_Err(t *testing.T) {
+	for _, template := range []string{"%", "%H%", "%X", "%f", "%n{8", "%r{-1}", "%n{x}"} {
+		t.Run(template, func(t *testing.T) {
+			_, err := tc.ParseTemplate(template)
+			assert.ErrorIs(t, err, tc.ErrParseTemplate)
+		})
+	}
+
+	assert.Panics(t, func() { tc.MustParseTemplate("%X") })
+}
@@ -0,0 +1,119 @@
+package tc
+
+import (
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"math/big"
+	"strconv"
+)
+
+// hfrMaxTimebase is the highest timebase that SMPTE ST 12 can count in the frames
+// place of a timecode.
+const hfrMaxTimebase int64 = 60
+
+// hfrMultiplier returns the number of frames that share a single timecode frames value
+// in SMPTE ST 12-3 high-frame-rate timecode, along with the timebase of the frames
+// place.
+//
+// Timebases of 60 and below, and timebases that are not whole numbers, return a
+// multiplier of 1.
+func hfrMultiplier(framerate rate.Framerate) (multiplier int64, baseTimebase *big.Rat) {
+	timebase := framerate.Timebase()
+	if !timebase.IsInt() || timebase.Num().Int64() <= hfrMaxTimebase {
+		return 1, timebase
+	}
+
+	timebaseInt := timebase.Num().Int64()
+
+	// Find the smallest whole divisor of the timebase that brings it down into the
+	// range of the frames place.
+	for multiplier = 2; multiplier < timebaseInt; multiplier++ {
+		if timebaseInt%multiplier == 0 && timebaseInt/multiplier <= hfrMaxTimebase {
+			break
+		}
+	}
+
+	return multiplier, big.NewRat(timebaseInt/multiplier, 1)
+}
+
+// frameCounting returns the number of frames that share a single frames place value
+// in this notation, along with the timebase of the frames place. It is always 1 and
+// the framerate timebase unless HFR is set.
+func (notation Notation) frameCounting(framerate rate.Framerate) (multiplier int64, baseTimebase *big.Rat) {
+	if !notation.HFR {
+		return 1, framerate.Timebase()
+	}
+	return hfrMultiplier(framerate)
+}
+
+/*
+Sections returns the individual sections of tc as counted in this notation. Unless HFR
+is set, it is the same as Timecode.Sections.
+
+What it is
+
+SMPTE ST 12 timecode can only count up to 59 in the frames place. For rates above 60
+fps, like 100, 119.88 or 120, ST 12-3 counts the frames place at a fraction of the
+timebase (50 for 100 fps, 60 for 120 fps), and uses a flag to mark which frame of each
+group is being referenced. When HFR is set, Sections counts the frames place this way,
+and reports the flag as HFRIndex.
+
+Drop-frame rates, like 119.88 DF, drop frames based on the frames place timebase, so
+119.88 DF drops in the same places as 59.94 DF.
+
+Where you see it
+
+• High-frame-rate camera and recorder displays.
+
+• HFR sports and VR broadcast hardware.
+*/
+func (notation Notation) Sections(tc Timecode) TimecodeSections {
+	multiplier, baseTimebase := notation.frameCounting(tc.rate)
+	if multiplier == 1 {
+		return tc.Sections()
+	}
+
+	frames := tc.Frames()
+	isNegative := tc.IsNegative()
+	if isNegative {
+		frames = -frames
+	}
+
+	sections := sectionsAtTimebase(
+		frames/multiplier, isNegative, baseTimebase, tc.rate.NTSC() == rate.NTSCDrop,
+	)
+	sections.HFRIndex = frames % multiplier
+
+	return tc.splitDays(sections)
+}
+
+// hfrIndexFromMatch returns the HFRIndex from the suffix of a timecode match parsed in
+// a notation with HFR set. Subframe suffixes are not allowed, so that they cannot be
+// mistaken for an index.
+func hfrIndexFromMatch(match []string, multiplier int64, framerate rate.Framerate) (int64, error) {
+	if match[timecodeRegexSubframes] != "" {
+		return 0, fieldError(
+			ErrBadSubframeValue,
+			fieldSubframes,
+			"subframes are not allowed in high-frame-rate timecode",
+		)
+	}
+
+	indexStr := match[timecodeRegexField]
+	if indexStr == "" {
+		return 0, nil
+	}
+
+	index, _ := strconv.ParseInt(indexStr, 10, 64)
+	if index >= multiplier {
+		return 0, fieldError(
+			ErrBadSubframeValue,
+			fieldHFRIndex,
+			"hfr index %v must be less than %v for %v",
+			index,
+			multiplier,
+			framerate,
+		)
+	}
+
+	return index, nil
+}
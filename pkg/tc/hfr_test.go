@@ -0,0 +1,167 @@
+package tc_test
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func mustRate(timebase int64, ntsc rate.NTSC) rate.Framerate {
+	framerate, err := rate.FromInt(timebase, ntsc)
+	if err != nil {
+		panic(fmt.Errorf("error creating framerate %v: %w", timebase, err))
+	}
+
+	return framerate
+}
+
+func TestNotationHFR(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Rate        rate.Framerate
+		Frames      int64
+		TimecodeHFR string
+		Timecode    string
+		Sections    tc.TimecodeSections
+	}{
+		{
+			Name:        "120 fps pair 1",
+			Rate:        mustRate(120, rate.NTSCNone),
+			Frames:      119,
+			TimecodeHFR: "00:00:00:59.1",
+			Timecode:    "00:00:00:119",
+			Sections:    tc.TimecodeSections{Frames: 59, HFRIndex: 1},
+		},
+		{
+			Name:        "120 fps 1 hour",
+			Rate:        mustRate(120, rate.NTSCNone),
+			Frames:      432000,
+			TimecodeHFR: "01:00:00:00.0",
+			Timecode:    "01:00:00:00",
+			Sections:    tc.TimecodeSections{Hours: 1},
+		},
+		{
+			Name:        "119.88 NDF",
+			Rate:        mustRate(120, rate.NTSCNonDrop),
+			Frames:      241,
+			TimecodeHFR: "00:00:02:00.1",
+			Timecode:    "00:00:02:01",
+			Sections:    tc.TimecodeSections{Seconds: 2, HFRIndex: 1},
+		},
+		{
+			Name:        "119.88 DF",
+			Rate:        mustRate(120, rate.NTSCDrop),
+			Frames:      7200,
+			TimecodeHFR: "00:01:00;04.0",
+			Timecode:    "00:01:00;08",
+			Sections:    tc.TimecodeSections{Minutes: 1, Frames: 4},
+		},
+		{
+			Name:        "119.88 DF 10 minutes",
+			Rate:        mustRate(120, rate.NTSCDrop),
+			Frames:      71929,
+			TimecodeHFR: "00:10:00;00.1",
+			Timecode:    "00:10:00;01",
+			Sections:    tc.TimecodeSections{Minutes: 10, HFRIndex: 1},
+		},
+		{
+			Name:        "100 fps",
+			Rate:        mustRate(100, rate.NTSCNone),
+			Frames:      199,
+			TimecodeHFR: "00:00:01:49.1",
+			Timecode:    "00:00:01:99",
+			Sections:    tc.TimecodeSections{Seconds: 1, Frames: 49, HFRIndex: 1},
+		},
+		{
+			Name:        "60 fps",
+			Rate:        rate.F60,
+			Frames:      119,
+			TimecodeHFR: "00:00:01:59",
+			Timecode:    "00:00:01:59",
+			Sections:    tc.TimecodeSections{Seconds: 1, Frames: 59},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			timecode := tc.FromFrames(testCase.Frames, testCase.Rate)
+			assert.Equal(testCase.TimecodeHFR, tc.NotationHFR.Timecode(timecode), "timecode HFR")
+			assert.Equal(testCase.Timecode, timecode.Timecode(), "timecode")
+			assert.Equal(testCase.Sections, tc.NotationHFR.Sections(timecode), "sections")
+
+			parsed, err := tc.NotationHFR.FromTimecode(testCase.TimecodeHFR, testCase.Rate)
+			if !assert.NoError(err, "parse HFR") {
+				t.FailNow()
+			}
+			assert.Equal(testCase.Frames, parsed.Frames(), "parsed frames")
+
+			parsed, err = tc.NotationHFR.FromTimecode("-"+testCase.TimecodeHFR, testCase.Rate)
+			if !assert.NoError(err, "parse negative HFR") {
+				t.FailNow()
+			}
+			assert.Equal(-testCase.Frames, parsed.Frames(), "parsed negative frames")
+			assert.Equal("-"+testCase.TimecodeHFR, tc.NotationHFR.Timecode(parsed), "negative timecode HFR")
+		})
+	}
+}
+
+func TestNotationHFR_FromTimecodeErr(t *testing.T) {
+	cases := []struct {
+		In       string
+		Rate     rate.Framerate
+		Expected error
+	}{
+		{In: "00:00:00:59.2", Rate: mustRate(120, rate.NTSCNone), Expected: tc.ErrBadSubframeValue},
+		{In: "00:00:00:59.1", Rate: rate.F60, Expected: tc.ErrBadSubframeValue},
+		{In: "00:00:00:59+50", Rate: mustRate(120, rate.NTSCNone), Expected: tc.ErrBadSubframeValue},
+		{In: "00:01:00;00.0", Rate: mustRate(120, rate.NTSCDrop), Expected: tc.ErrBadDropFrameValue},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.In, func(t *testing.T) {
+			_, err := tc.NotationHFR.FromTimecode(testCase.In, testCase.Rate)
+			assert.ErrorIs(t, err, tc.ErrParseTimecode, "is parse err")
+			assert.ErrorIs(t, err, testCase.Expected, "is correct sub err")
+		})
+	}
+}
+
+func TestNotationHFR_NotConfusedWithFields(t *testing.T) {
+	assert := assert.New(t)
+
+	const label = "01:00:00:59.1"
+	framerate := mustRate(120, rate.NTSCNone)
+
+	// SMPTE notation always reads the suffix as the second field of frame 59.
+	field, err := tc.FromTimecode(label, framerate)
+	if !assert.NoError(err, "parse field") {
+		t.FailNow()
+	}
+	assert.Equal(int64(864119), field.Fields(), "fields")
+	assert.Equal(int64(1), field.Field(), "field")
+	assert.Equal(label, field.TimecodeField(), "field round trip")
+
+	// NotationHFR always reads it as the second frame of frame pair 59.
+	hfr, err := tc.NotationHFR.FromTimecode(label, framerate)
+	if !assert.NoError(err, "parse HFR") {
+		t.FailNow()
+	}
+	assert.Equal(int64(432119), hfr.Frames(), "HFR frames")
+	assert.Equal(int64(0), hfr.Field(), "HFR field")
+	assert.Equal("01:00:00:119", hfr.Timecode(), "HFR as SMPTE")
+	assert.Equal(label, tc.NotationHFR.Timecode(hfr), "HFR round trip")
+
+	assert.Equal(tc.CmpLt, field.Cmp(hfr), "interpretations differ")
+
+	// Strict parsing checks the frames place against the ST 12-3 timebase.
+	opts := tc.StrictParseOptions
+	opts.Notation = &tc.NotationHFR
+	_, err = opts.FromTimecode(label, framerate)
+	assert.NoError(err, "strict HFR")
+	_, err = opts.FromTimecode("01:00:00:60.0", framerate)
+	assert.ErrorIs(err, tc.ErrSectionOverflow, "strict HFR overflow")
+}
@@ -0,0 +1,222 @@
+package tc
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/internal"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/wadey/go-rounding"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// HumanStyle is an enum-like type picking how Timecode.Human writes a duration.
+type HumanStyle int
+
+const (
+	// HumanFrames writes whole hours, minutes and seconds of real time, then the
+	// remaining frames: (ex: 1h 02m 03s 04f). Places before the first non-zero place
+	// are left off.
+	HumanFrames HumanStyle = iota
+	// HumanMinutes writes whole minutes of real time, then the remaining seconds as a
+	// decimal: (ex: 62m 3.17s).
+	HumanMinutes
+	// HumanSeconds writes the real time in seconds as a decimal: (ex: 3723.17s).
+	HumanSeconds
+	// HumanMilliseconds writes the real time in whole milliseconds: (ex: 3723167ms).
+	HumanMilliseconds
+)
+
+// maxHumanPrecision is the most decimal places Timecode.Human will write for seconds.
+const maxHumanPrecision = 9
+
+/*
+Human returns the real-world duration of the timecode as a unit-suffixed string, like
+a producer would write it, in style.
+
+The hours, minutes and seconds are split from Seconds the same way as Runtime, so for
+NTSC framerates the value will drift from the Timecode value.
+
+Decimal seconds are written with the fewest decimal places that still parse back to the
+same frame, and frames are rounded to the nearest frame of real time. For values which
+land on a whole frame, passing the result to FromHuman with the same framerate returns a
+Timecode with the exact same Seconds.
+
+Note: this method will panic if style is not one of the HumanStyle values.
+
+Where you see it
+
+• Production reports and schedules.
+
+• Durations typed into review and editorial tools.
+*/
+func (tc Timecode) Human(style HumanStyle) string {
+	seconds := tc.Seconds()
+	// If this is a negative value, make it positive for the purposes of splitting the
+	// value.
+	isNegative := tc.IsNegative()
+	if isNegative {
+		seconds.Neg(seconds)
+	}
+
+	var human string
+	switch style {
+	case HumanFrames:
+		human = tc.humanFrames(seconds)
+	case HumanMinutes:
+		rounded, precision := tc.humanDecimal(seconds)
+		minutes, remainder := internal.DivModRat(rounded, secondsPerMinuteRat)
+		human = remainder.FloatString(precision) + "s"
+		if minutes.Sign() != 0 {
+			human = fmt.Sprintf("%vm %v", minutes.Num(), human)
+		}
+	case HumanSeconds:
+		rounded, precision := tc.humanDecimal(seconds)
+		human = rounded.FloatString(precision) + "s"
+	case HumanMilliseconds:
+		milliseconds := internal.RoundRat(seconds.Mul(seconds, millisecondsPerSecondRat))
+		human = milliseconds.Num().String() + "ms"
+	default:
+		panic(fmt.Sprintf("unknown HumanStyle %d", style))
+	}
+
+	if isNegative {
+		human = "-" + human
+	}
+	return human
+}
+
+// humanFrames returns positive seconds in the HumanFrames style.
+func (tc Timecode) humanFrames(seconds *big.Rat) string {
+	playback := tc.rate.Playback()
+
+	// Rounding the frames place to the nearest frame could carry it over into the next
+	// second, so we shift the value by half a frame and floor it instead.
+	halfFrame := new(big.Rat).Inv(playback)
+	halfFrame.Quo(halfFrame, big.NewRat(2, 1))
+	seconds.Add(seconds, halfFrame)
+
+	hours, seconds := internal.DivModRat(seconds, secondsPerHourRat)
+	minutes, seconds := internal.DivModRat(seconds, secondsPerMinuteRat)
+	wholeSeconds, seconds := internal.DivModRat(seconds, secondsPerSecondRat)
+	frames, _ := internal.DivModRat(seconds.Mul(seconds, playback), secondsPerSecondRat)
+
+	places := []struct {
+		value int64
+		unit  string
+	}{
+		{hours.Num().Int64(), "h"},
+		{minutes.Num().Int64(), "m"},
+		{wholeSeconds.Num().Int64(), "s"},
+		{frames.Num().Int64(), "f"},
+	}
+
+	builder := strings.Builder{}
+	for i, place := range places {
+		isLast := i == len(places)-1
+		switch {
+		case builder.Len() == 0 && place.value == 0 && !isLast:
+			continue
+		case builder.Len() == 0:
+			builder.WriteString(fmt.Sprintf("%d%v", place.value, place.unit))
+		default:
+			builder.WriteString(fmt.Sprintf(" %02d%v", place.value, place.unit))
+		}
+	}
+
+	return builder.String()
+}
+
+// humanDecimal returns positive seconds rounded to the fewest decimal places that still
+// round to the same frame, and the number of places.
+func (tc Timecode) humanDecimal(seconds *big.Rat) (rounded *big.Rat, precision int) {
+	playback := tc.rate.Playback()
+	frames := internal.RoundRat(new(big.Rat).Mul(seconds, playback))
+
+	for precision = 0; precision < maxHumanPrecision; precision++ {
+		rounded = rounding.Round(new(big.Rat).Set(seconds), precision, rounding.HalfUp)
+		roundedFrames := internal.RoundRat(new(big.Rat).Mul(rounded, playback))
+		if roundedFrames.Cmp(frames) == 0 {
+			return rounded, precision
+		}
+	}
+
+	return rounding.Round(seconds, precision, rounding.HalfUp), precision
+}
+
+// humanNumber matches the value of a place in a human-readable duration.
+const humanNumber = `([0-9]+(?:\.[0-9]+)?)`
+
+// humanRegex parses human-readable durations. Places may be left off, but must be in
+// order.
+var humanRegex = regexp.MustCompile(
+	`(?i)^(-)?\s*` +
+		`(?:` + humanNumber + `\s*h\s*)?` +
+		`(?:` + humanNumber + `\s*m\s*)?` +
+		`(?:` + humanNumber + `\s*s\s*)?` +
+		`(?:` + humanNumber + `\s*ms\s*)?` +
+		`(?:` + humanNumber + `\s*f\s*)?$`,
+)
+
+// Indexes of the submatch groups of humanRegex.
+const (
+	humanRegexNegative     = 1
+	humanRegexHours        = 2
+	humanRegexMinutes      = 3
+	humanRegexSeconds      = 4
+	humanRegexMilliseconds = 5
+	humanRegexFrames       = 6
+)
+
+// FromHuman parses a unit-suffixed, real-world duration like the ones written by
+// Timecode.Human: (ex: '1h 02m 03s 04f', '62m 3.17s', '3723.17s' or '1500ms').
+//
+// The units are 'h', 'm', 's', 'ms' and 'f', and must be in that order. Any unit may
+// be left off, but at least one must be present. Places may be decimal and may
+// overflow, so '1.5h' and '90m' are both valid. The result is rounded to the nearest
+// frame.
+//
+// Errors are returned as a *ParseError.
+func FromHuman(value string, framerate rate.Framerate) (Timecode, error) {
+	match := humanRegex.FindStringSubmatch(value)
+
+	// Every place is optional, so the regex will match an empty value.
+	isEmpty := true
+	if match != nil {
+		isEmpty = strings.Join(match[humanRegexHours:], "") == ""
+	}
+
+	if isEmpty {
+		err := notRecognized(
+			"expected a duration like '%v' or '%v'",
+			exampleTimecode.Human(HumanFrames),
+			exampleTimecode.Human(HumanSeconds),
+		)
+		return Timecode{}, withInput(err, value, FormatHuman, nil)
+	}
+
+	seconds := new(big.Rat)
+	for _, place := range []struct {
+		group int
+		scale *big.Rat
+	}{
+		{humanRegexHours, secondsPerHourRat},
+		{humanRegexMinutes, secondsPerMinuteRat},
+		{humanRegexSeconds, secondsPerSecondRat},
+		{humanRegexMilliseconds, new(big.Rat).Inv(millisecondsPerSecondRat)},
+		{humanRegexFrames, new(big.Rat).Inv(framerate.Playback())},
+	} {
+		if match[place.group] == "" {
+			continue
+		}
+		// The regex only matches valid decimals, so we don't need to check.
+		placeValue, _ := new(big.Rat).SetString(match[place.group])
+		seconds.Add(seconds, placeValue.Mul(placeValue, place.scale))
+	}
+
+	if match[humanRegexNegative] != "" {
+		seconds.Neg(seconds)
+	}
+
+	return FromSeconds(seconds, framerate), nil
+}
@@ -0,0 +1,198 @@
+package tc_test
+
+import (
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTimecode_Human(t *testing.T) {
+	cases := []struct {
+		Timecode     string
+		Rate         rate.Framerate
+		Frames       string
+		Minutes      string
+		Seconds      string
+		Milliseconds string
+	}{
+		{
+			Timecode:     "01:02:03:04",
+			Rate:         rate.F24,
+			Frames:       "1h 02m 03s 04f",
+			Minutes:      "62m 3.17s",
+			Seconds:      "3723.17s",
+			Milliseconds: "3723167ms",
+		},
+		{
+			Timecode:     "00:00:03:12",
+			Rate:         rate.F24,
+			Frames:       "3s 12f",
+			Minutes:      "3.5s",
+			Seconds:      "3.5s",
+			Milliseconds: "3500ms",
+		},
+		{
+			Timecode:     "00:00:00:00",
+			Rate:         rate.F24,
+			Frames:       "0f",
+			Minutes:      "0s",
+			Seconds:      "0s",
+			Milliseconds: "0ms",
+		},
+		{
+			Timecode:     "01:00:00:00",
+			Rate:         rate.F24,
+			Frames:       "1h 00m 00s 00f",
+			Minutes:      "60m 0s",
+			Seconds:      "3600s",
+			Milliseconds: "3600000ms",
+		},
+		{
+			Timecode:     "01:00:00:00",
+			Rate:         rate.F23_98,
+			Frames:       "1h 00m 03s 14f",
+			Minutes:      "60m 3.6s",
+			Seconds:      "3603.6s",
+			Milliseconds: "3603600ms",
+		},
+		{
+			Timecode:     "00:00:00:01",
+			Rate:         rate.F23_98,
+			Frames:       "1f",
+			Minutes:      "0.04s",
+			Seconds:      "0.04s",
+			Milliseconds: "42ms",
+		},
+		{
+			Timecode:     "00:10:00;00",
+			Rate:         rate.F29_97Df,
+			Frames:       "10m 00s 00f",
+			Minutes:      "10m 0s",
+			Seconds:      "600s",
+			Milliseconds: "599999ms",
+		},
+		{
+			Timecode:     "00:00:59;29",
+			Rate:         rate.F29_97Df,
+			Frames:       "1m 00s 01f",
+			Minutes:      "1m 0.03s",
+			Seconds:      "60.03s",
+			Milliseconds: "60027ms",
+		},
+		{
+			Timecode:     "-00:00:01:12",
+			Rate:         rate.F24,
+			Frames:       "-1s 12f",
+			Minutes:      "-1.5s",
+			Seconds:      "-1.5s",
+			Milliseconds: "-1500ms",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Timecode+" @ "+testCase.Rate.String(), func(t *testing.T) {
+			timecode, err := tc.FromTimecode(testCase.Timecode, testCase.Rate)
+			if !assert.NoError(t, err, "parse timecode") {
+				t.FailNow()
+			}
+
+			styles := []struct {
+				Name     string
+				Style    tc.HumanStyle
+				Expected string
+			}{
+				{Name: "Frames", Style: tc.HumanFrames, Expected: testCase.Frames},
+				{Name: "Minutes", Style: tc.HumanMinutes, Expected: testCase.Minutes},
+				{Name: "Seconds", Style: tc.HumanSeconds, Expected: testCase.Seconds},
+				{Name: "Milliseconds", Style: tc.HumanMilliseconds, Expected: testCase.Milliseconds},
+			}
+
+			for _, style := range styles {
+				t.Run(style.Name, func(t *testing.T) {
+					human := timecode.Human(style.Style)
+					assert.Equal(t, style.Expected, human, "human")
+
+					parsed, err := tc.FromHuman(human, testCase.Rate)
+					if !assert.NoError(t, err, "parse human") {
+						t.FailNow()
+					}
+
+					assert.Equal(t, timecode.Seconds(), parsed.Seconds(), "round trip seconds")
+				})
+			}
+		})
+	}
+}
+
+// Every frame of an hour should survive a round trip through each style.
+func TestTimecode_Human_RoundTrip(t *testing.T) {
+	styles := []tc.HumanStyle{tc.HumanFrames, tc.HumanMinutes, tc.HumanSeconds, tc.HumanMilliseconds}
+	rates := []rate.Framerate{rate.F23_98, rate.F29_97Df, rate.F59_94Ndf, mustRate(25, rate.NTSCNone)}
+
+	for _, framerate := range rates {
+		t.Run(framerate.String(), func(t *testing.T) {
+			for frame := int64(-100); frame < 86400; frame += 31 {
+				timecode := tc.FromFrames(frame, framerate)
+				for _, style := range styles {
+					human := timecode.Human(style)
+					parsed, err := tc.FromHuman(human, framerate)
+					if !assert.NoError(t, err, "parse %v", human) ||
+						!assert.Equal(t, frame, parsed.Frames(), "round trip %v", human) {
+						t.FailNow()
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFromHuman(t *testing.T) {
+	cases := []struct {
+		In       string
+		Rate     rate.Framerate
+		Expected string
+	}{
+		{In: "1h 02m 03s 04f", Rate: rate.F24, Expected: "01:02:03:04"},
+		{In: "1h02m03s04f", Rate: rate.F24, Expected: "01:02:03:04"},
+		{In: "1H 2M 3S 4F", Rate: rate.F24, Expected: "01:02:03:04"},
+		{In: "62m 3.17s", Rate: rate.F24, Expected: "01:02:03:04"},
+		{In: "3723.17s", Rate: rate.F24, Expected: "01:02:03:04"},
+		{In: "1500ms", Rate: rate.F24, Expected: "00:00:01:12"},
+		{In: "1s 500ms", Rate: rate.F24, Expected: "00:00:01:12"},
+		{In: "1 s 12 f", Rate: rate.F24, Expected: "00:00:01:12"},
+		{In: "1.5h", Rate: rate.F24, Expected: "01:30:00:00"},
+		{In: "90m", Rate: rate.F24, Expected: "01:30:00:00"},
+		{In: "12f", Rate: rate.F24, Expected: "00:00:00:12"},
+		{In: "-1s 12f", Rate: rate.F24, Expected: "-00:00:01:12"},
+		{In: "3603.6s", Rate: rate.F23_98, Expected: "01:00:00:00"},
+		{In: "10m", Rate: rate.F29_97Df, Expected: "00:10:00;00"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.In, func(t *testing.T) {
+			parsed, err := tc.FromHuman(testCase.In, testCase.Rate)
+			if !assert.NoError(t, err, "parse") {
+				t.FailNow()
+			}
+			assert.Equal(t, testCase.Expected, parsed.Timecode(), "timecode")
+		})
+	}
+}
+
+func TestFromHuman_Errors(t *testing.T) {
+	cases := []string{"", "-", "1h 2", "3s 1h", "1d", "1.s", "01:00:00:00", "1h 1h"}
+
+	for _, testCase := range cases {
+		t.Run(testCase, func(t *testing.T) {
+			_, err := tc.FromHuman(testCase, rate.F24)
+			assert.ErrorIs(t, err, tc.ErrParseTimecode, "is parse err")
+			assert.ErrorIs(t, err, tc.ErrFormatNotRecognized, "is correct sub err")
+
+			parseErr, ok := err.(*tc.ParseError)
+			if assert.True(t, ok, "is ParseError") {
+				assert.Equal(t, tc.FormatHuman, parseErr.Format, "format")
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package tc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// keyKodeFootageRollover is the footage count at which a KeyKode footage counter rolls
+// back over to 0. The counter is printed with four digits.
+const keyKodeFootageRollover int64 = 10000
+
+/*
+KeyKode is a machine-readable edge code printed on physical film stock by the
+manufacturer. ex: 'KJ 23 1234 5678+12'.
+
+What it is
+
+Every foot of a film negative is printed with a unique identifier made up of a
+manufacturer code, a film stock code, a six-digit prefix identifying the roll, and a
+running footage count. A frame offset from the last printed footage count is added to
+reference an individual frame.
+
+Where you see it
+
+• Negative-cutting lists.
+
+• Telecine logs (FLEx / ALE files) that sync KeyKode to video timecode.
+
+• Burned into dailies for conform reference.
+*/
+type KeyKode struct {
+	// Manufacturer is the single-letter manufacturer code, like 'K' for Kodak.
+	Manufacturer string
+	// Stock is the single-character film stock code.
+	Stock string
+	// Prefix is the six-digit roll identifier. It is printed in two groups: '23 1234'.
+	Prefix string
+	// Footage is the running footage count. It is printed with 4 digits, and rolls
+	// over to 0 after 9999.
+	Footage int64
+	// Frames is the frame offset from Footage.
+	Frames int64
+	// Format is the film format the KeyKode is printed on.
+	Format FilmFormat
+}
+
+// String implements fmt.Stringer.
+func (kk KeyKode) String() string {
+	prefix := kk.Prefix
+	if len(prefix) == 6 {
+		prefix = prefix[:2] + " " + prefix[2:]
+	}
+
+	return fmt.Sprintf(
+		"%v%v %v %04d+%02d", kk.Manufacturer, kk.Stock, prefix, kk.Footage, kk.Frames,
+	)
+}
+
+// SameRoll returns true if other was printed on the same roll of film as kk.
+func (kk KeyKode) SameRoll(other KeyKode) bool {
+	return kk.Manufacturer == other.Manufacturer &&
+		kk.Stock == other.Stock &&
+		kk.Prefix == other.Prefix &&
+		kk.Format == other.Format
+}
+
+// AddFrames returns a new KeyKode offset by frames. If the footage count would pass
+// 9999 or go below 0, it rolls over in the same way the printed counter does.
+//
+// For formats where frames straddle feet, like 35mm 3-perf, the rollover happens on
+// the first whole frame of foot 10000.
+func (kk KeyKode) AddFrames(frames int64) KeyKode {
+	period := joinFeetAndFrames(keyKodeFootageRollover, 0, kk.Format)
+
+	total := kk.totalFrames() + frames
+	total %= period
+	if total < 0 {
+		total += period
+	}
+
+	kk.Footage, kk.Frames = splitFeetAndFrames(total, kk.Format)
+	return kk
+}
+
+// FramesTo returns the number of frames between kk and other, which will be negative
+// if other comes before kk.
+//
+// Since the footage counter rolls over, the shortest distance between the two values
+// is returned, so '9999+15' to '0000+00' is 1 frame.
+//
+// ErrKeyKodeRoll is returned if other is not from the same roll of film.
+func (kk KeyKode) FramesTo(other KeyKode) (int64, error) {
+	if !kk.SameRoll(other) {
+		return 0, fmt.Errorf("%w: '%v' and '%v'", ErrKeyKodeRoll, kk, other)
+	}
+
+	period := joinFeetAndFrames(keyKodeFootageRollover, 0, kk.Format)
+
+	distance := (other.totalFrames() - kk.totalFrames()) % period
+	if distance < 0 {
+		distance += period
+	}
+	if distance > period/2 {
+		distance -= period
+	}
+
+	return distance, nil
+}
+
+// Timecode returns the Timecode of kk, using sync as a reference point.
+//
+// ErrKeyKodeRoll is returned if kk is not from the same roll of film as sync.
+func (kk KeyKode) Timecode(sync KeyKodeSync) (Timecode, error) {
+	offset, err := sync.KeyKode.FramesTo(kk)
+	if err != nil {
+		return Timecode{}, err
+	}
+
+	return FromFrames(sync.Timecode.Frames()+offset, sync.Timecode.Rate()), nil
+}
+
+// totalFrames returns the frame count of the KeyKode from footage 0000+00.
+func (kk KeyKode) totalFrames() int64 {
+	return joinFeetAndFrames(kk.Footage, kk.Frames, kk.Format)
+}
+
+// KeyKodeSync is a reference point used to convert between KeyKode and Timecode
+// values, like the sync point a telecine log records for each roll.
+type KeyKodeSync struct {
+	// KeyKode is the KeyKode of the reference frame.
+	KeyKode KeyKode
+	// Timecode is the Timecode of the reference frame.
+	Timecode Timecode
+}
+
+// KeyKode returns the KeyKode of the Timecode, using sync as a reference point.
+//
+// The Timecode is first converted to the framerate of the sync Timecode.
+func (tc Timecode) KeyKode(sync KeyKodeSync) KeyKode {
+	frames := FromSeconds(tc.seconds, sync.Timecode.rate).Frames()
+	return sync.KeyKode.AddFrames(frames - sync.Timecode.Frames())
+}
+
+// keyKodeRegex will be used to parse KeyKode values.
+var keyKodeRegex = regexp.MustCompile(
+	`^(?P<manufacturer>[A-Z])(?P<stock>[A-Z0-9])\s*` +
+		`(?P<prefix1>[0-9]{2})\s*(?P<prefix2>[0-9]{4})\s*` +
+		`(?P<footage>[0-9]{4})\+(?P<frames>[0-9]{1,2})$`,
+)
+
+// Indexes of our submatch groups.
+const (
+	keyKodeRegexManufacturer = 1
+	keyKodeRegexStock        = 2
+	keyKodeRegexPrefix1      = 3
+	keyKodeRegexPrefix2      = 4
+	keyKodeRegexFootage      = 5
+	keyKodeRegexFrames       = 6
+)
+
+// ParseKeyKode parses a KeyKode string like 'KJ 23 1234 5678+12' printed on film of
+// the given format. The whitespace between groups is optional.
+//
+// Errors are returned as a *ParseError wrapping ErrParseKeyKode.
+func ParseKeyKode(value string, format FilmFormat) (KeyKode, error) {
+	keykode, err := parseKeyKode(value, format)
+	if err != nil {
+		return KeyKode{}, withInput(err, value, FormatUnknown, keyKodeOffsets(value))
+	}
+	return keykode, nil
+}
+
+// parseKeyKode implements ParseKeyKode without filling in ParseError details.
+func parseKeyKode(value string, format FilmFormat) (KeyKode, error) {
+	match := keyKodeRegex.FindStringSubmatch(value)
+	if match == nil {
+		return KeyKode{}, fieldError(
+			ErrParseKeyKode, "", "expected a KeyKode like 'KJ 23 1234 5678+12'",
+		)
+	}
+
+	footage, _ := strconv.ParseInt(match[keyKodeRegexFootage], 10, 64)
+	frames, _ := strconv.ParseInt(match[keyKodeRegexFrames], 10, 64)
+
+	if err := format.Validate(); err != nil {
+		return KeyKode{}, fieldError(ErrParseKeyKode, "", "%v", err)
+	}
+
+	if inFoot := format.framesInFoot(footage); frames >= inFoot {
+		return KeyKode{}, fieldError(
+			ErrParseKeyKode,
+			fieldFrames,
+			"frame offset '%v' must be less than '%v' for foot '%v' of %v film",
+			frames,
+			inFoot,
+			footage,
+			format,
+		)
+	}
+
+	return KeyKode{
+		Manufacturer: match[keyKodeRegexManufacturer],
+		Stock:        match[keyKodeRegexStock],
+		Prefix:       match[keyKodeRegexPrefix1] + match[keyKodeRegexPrefix2],
+		Footage:      footage,
+		Frames:       frames,
+		Format:       format,
+	}, nil
+}
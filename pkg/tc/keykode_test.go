@@ -0,0 +1,186 @@
+package tc_test
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func mustKeyKode(value string, format tc.FilmFormat) tc.KeyKode {
+	parsed, err := tc.ParseKeyKode(value, format)
+	if err != nil {
+		panic(fmt.Errorf("error parsing keykode '%v': %w", value, err))
+	}
+
+	return parsed
+}
+
+func TestParseKeyKode(t *testing.T) {
+	cases := []struct {
+		In       string
+		Format   tc.FilmFormat
+		Expected tc.KeyKode
+	}{
+		{
+			In:     "KJ 23 1234 5678+12",
+			Format: tc.Film35mm4Perf,
+			Expected: tc.KeyKode{
+				Manufacturer: "K",
+				Stock:        "J",
+				Prefix:       "231234",
+				Footage:      5678,
+				Frames:       12,
+				Format:       tc.Film35mm4Perf,
+			},
+		},
+		{
+			In:     "KJ231234 0012+5",
+			Format: tc.Film35mm4Perf,
+			Expected: tc.KeyKode{
+				Manufacturer: "K",
+				Stock:        "J",
+				Prefix:       "231234",
+				Footage:      12,
+				Frames:       5,
+				Format:       tc.Film35mm4Perf,
+			},
+		},
+		{
+			In:     "EB 11 2222 0100+39",
+			Format: tc.Film16mm,
+			Expected: tc.KeyKode{
+				Manufacturer: "E",
+				Stock:        "B",
+				Prefix:       "112222",
+				Footage:      100,
+				Frames:       39,
+				Format:       tc.Film16mm,
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.In, func(t *testing.T) {
+			assert := assert.New(t)
+
+			parsed, err := tc.ParseKeyKode(testCase.In, testCase.Format)
+			if !assert.NoError(err, "parse") {
+				t.FailNow()
+			}
+
+			assert.Equal(testCase.Expected, parsed, "keykode")
+		})
+	}
+}
+
+func TestParseKeyKode_Err(t *testing.T) {
+	cases := []struct {
+		In     string
+		Format tc.FilmFormat
+	}{
+		{In: "not a keykode", Format: tc.Film35mm4Perf},
+		{In: "KJ 23 1234 5678", Format: tc.Film35mm4Perf},
+		{In: "KJ 23 1234 5678+16", Format: tc.Film35mm4Perf},
+		{In: "KJ 23 1234 5678+40", Format: tc.Film16mm},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.In, func(t *testing.T) {
+			_, err := tc.ParseKeyKode(testCase.In, testCase.Format)
+			assert.ErrorIs(t, err, tc.ErrParseKeyKode)
+			assert.ErrorIs(t, err, tc.ErrParseTimecode)
+
+			var parseErr *tc.ParseError
+			if assert.ErrorAs(t, err, &parseErr) {
+				assert.Equal(t, testCase.In, parseErr.Input, "input")
+			}
+		})
+	}
+}
+
+func TestKeyKode_String(t *testing.T) {
+	keykode := mustKeyKode("KJ231234 0012+5", tc.Film35mm4Perf)
+	assert.Equal(t, "KJ 23 1234 0012+05", keykode.String())
+}
+
+func TestKeyKode_AddFrames(t *testing.T) {
+	cases := []struct {
+		KeyKode  string
+		Frames   int64
+		Expected string
+	}{
+		{KeyKode: "KJ 23 1234 5678+12", Frames: 3, Expected: "KJ 23 1234 5678+15"},
+		{KeyKode: "KJ 23 1234 5678+12", Frames: 4, Expected: "KJ 23 1234 5679+00"},
+		{KeyKode: "KJ 23 1234 5678+12", Frames: -13, Expected: "KJ 23 1234 5677+15"},
+		{KeyKode: "KJ 23 1234 9999+15", Frames: 1, Expected: "KJ 23 1234 0000+00"},
+		{KeyKode: "KJ 23 1234 0000+00", Frames: -1, Expected: "KJ 23 1234 9999+15"},
+		{KeyKode: "KJ 23 1234 9990+00", Frames: 320, Expected: "KJ 23 1234 0010+00"},
+	}
+
+	for _, testCase := range cases {
+		name := fmt.Sprintf("%v + %v", testCase.KeyKode, testCase.Frames)
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			keykode := mustKeyKode(testCase.KeyKode, tc.Film35mm4Perf)
+			result := keykode.AddFrames(testCase.Frames)
+			assert.Equal(testCase.Expected, result.String(), "result")
+
+			distance, err := keykode.FramesTo(result)
+			if !assert.NoError(err, "frames to") {
+				t.FailNow()
+			}
+			assert.Equal(testCase.Frames, distance, "frames to")
+		})
+	}
+}
+
+func TestKeyKode_FramesTo_ErrRoll(t *testing.T) {
+	keykode1 := mustKeyKode("KJ 23 1234 5678+12", tc.Film35mm4Perf)
+	keykode2 := mustKeyKode("KJ 23 4321 5678+12", tc.Film35mm4Perf)
+
+	_, err := keykode1.FramesTo(keykode2)
+	assert.ErrorIs(t, err, tc.ErrKeyKodeRoll)
+}
+
+func TestKeyKode_Timecode(t *testing.T) {
+	sync := tc.KeyKodeSync{
+		KeyKode:  mustKeyKode("KJ 23 1234 9990+00", tc.Film35mm4Perf),
+		Timecode: mustTC("01:00:00:00", rate.F23_98),
+	}
+
+	cases := []struct {
+		KeyKode  string
+		Timecode string
+	}{
+		{KeyKode: "KJ 23 1234 9990+00", Timecode: "01:00:00:00"},
+		{KeyKode: "KJ 23 1234 9991+08", Timecode: "01:00:01:00"},
+		{KeyKode: "KJ 23 1234 9988+08", Timecode: "00:59:59:00"},
+		{KeyKode: "KJ 23 1234 0000+00", Timecode: "01:00:06:16"},
+		{KeyKode: "KJ 23 1234 0090+00", Timecode: "01:01:06:16"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.KeyKode, func(t *testing.T) {
+			assert := assert.New(t)
+
+			keykode := mustKeyKode(testCase.KeyKode, tc.Film35mm4Perf)
+
+			timecode, err := keykode.Timecode(sync)
+			if !assert.NoError(err, "to timecode") {
+				t.FailNow()
+			}
+			assert.Equal(testCase.Timecode, timecode.Timecode(), "timecode")
+
+			fromTimecode := mustTC(testCase.Timecode, rate.F23_98).KeyKode(sync)
+			assert.Equal(keykode, fromTimecode, "keykode")
+		})
+	}
+}
+
+func TestFilmFormat_String(t *testing.T) {
+	assert.Equal(t, "16mm", tc.Film16mm.String())
+	assert.Equal(t, "[INVALID FILM FORMAT]", tc.FilmFormat(100).String())
+}
@@ -0,0 +1,437 @@
+package tc
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/internal"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/wadey/go-rounding"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+/*
+Notation defines the separators, decimal mark and unit suffixes used to write timecode
+and runtime strings, so that dialects other than SMPTE's '01:00:00:00' can be formatted
+and parsed.
+
+Separators
+
+Separator is written between the days, hours, minutes and seconds places, and
+FrameSeparator or DropFrameSeparator between the seconds and frames places. When
+parsing, any of the three are accepted between any two places, except that a separator
+which is also the DecimalMark is only accepted before the frames place.
+
+Units
+
+If any of the unit fields are set, each place is written with its unit after it, like
+'01h00m00s00i', and places are identified by their units rather than their position
+when parsing. Every unit should be set, and should be unique, for values to parse
+unambiguously.
+
+Decimal mark
+
+DecimalMark separates whole seconds from fractional seconds in a runtime, and the frames
+place from a field suffix in a timecode.
+
+High frame rates
+
+If HFR is set, timecode is counted as SMPTE ST 12-3 high-frame-rate timecode: for rates
+above 60 fps, the frames place is followed by the DecimalMark and the HFRIndex instead
+of a field, like '01:00:00:59.1'. See Notation.Sections for more information. Field and
+subframe suffixes are never accepted in HFR notations, so '.1' is only ever read one way
+by a given Notation.
+*/
+type Notation struct {
+	// Separator is written between the days, hours, minutes and seconds places.
+	Separator string
+	// FrameSeparator is written between the seconds and frames places of
+	// non-drop-frame timecode.
+	FrameSeparator string
+	// DropFrameSeparator is written between the seconds and frames places of
+	// drop-frame timecode.
+	DropFrameSeparator string
+	// DecimalMark is written before fractional seconds and field suffixes.
+	DecimalMark string
+
+	// DaysUnit is written after the days place.
+	DaysUnit string
+	// HoursUnit is written after the hours place.
+	HoursUnit string
+	// MinutesUnit is written after the minutes place.
+	MinutesUnit string
+	// SecondsUnit is written after the seconds place.
+	SecondsUnit string
+	// FramesUnit is written after the frames place.
+	FramesUnit string
+
+	// HFR counts the frames place of rates above 60 fps as SMPTE ST 12-3
+	// high-frame-rate timecode, with the HFRIndex written after the DecimalMark.
+	HFR bool
+}
+
+// Predefined notations for common timecode dialects.
+var (
+	// NotationSMPTE is the standard SMPTE notation used by the package-level parsers
+	// and Timecode methods: '01:00:00:00', '01:00:00;00' for drop-frame, and
+	// '01:00:03.6' for runtimes.
+	NotationSMPTE = Notation{
+		Separator:          ":",
+		FrameSeparator:     ":",
+		DropFrameSeparator: ";",
+		DecimalMark:        ".",
+	}
+
+	// NotationPeriods is the dotted notation used by many European broadcasters:
+	// '01.00.00.00', and '01.00.03,6' for runtimes.
+	NotationPeriods = Notation{
+		Separator:          ".",
+		FrameSeparator:     ".",
+		DropFrameSeparator: ".",
+		DecimalMark:        ",",
+	}
+
+	// NotationPeriodDrop is the notation some NLEs export, which marks drop-frame
+	// timecode with a '.' before the frames place: '01:00:00.00'.
+	NotationPeriodDrop = Notation{
+		Separator:          ":",
+		FrameSeparator:     ":",
+		DropFrameSeparator: ".",
+		DecimalMark:        ".",
+	}
+
+	// NotationSRT is SMPTE notation with the comma decimal mark used by SubRip
+	// subtitles for runtimes: '01:00:03,6'.
+	NotationSRT = Notation{
+		Separator:          ":",
+		FrameSeparator:     ":",
+		DropFrameSeparator: ";",
+		DecimalMark:        ",",
+	}
+
+	// NotationUnits writes each place with a unit suffix, as used by some European
+	// broadcasters: '01h00m00s00i' ('i' for 'images'), and '01h00m03,6s' for runtimes.
+	NotationUnits = Notation{
+		DecimalMark: ",",
+		DaysUnit:    "d",
+		HoursUnit:   "h",
+		MinutesUnit: "m",
+		SecondsUnit: "s",
+		FramesUnit:  "i",
+	}
+
+	// NotationHFR is SMPTE notation with SMPTE ST 12-3 high-frame-rate timecode, as
+	// shown by HFR cameras and broadcast hardware: '01:00:00:59.1' for the second frame
+	// of the last frame pair in a second at 120 fps.
+	NotationHFR = Notation{
+		Separator:          ":",
+		FrameSeparator:     ":",
+		DropFrameSeparator: ";",
+		DecimalMark:        ".",
+		HFR:                true,
+	}
+)
+
+// hasUnits returns true if places are written with unit suffixes.
+func (notation Notation) hasUnits() bool {
+	return notation.DaysUnit != "" ||
+		notation.HoursUnit != "" ||
+		notation.MinutesUnit != "" ||
+		notation.SecondsUnit != "" ||
+		notation.FramesUnit != ""
+}
+
+// frameSeparator returns the separator between the seconds and frames places for
+// framerate.
+func (notation Notation) frameSeparator(framerate rate.Framerate) string {
+	if framerate.NTSC() == rate.NTSCDrop {
+		return notation.DropFrameSeparator
+	}
+	return notation.FrameSeparator
+}
+
+// Timecode returns tc formatted as a timecode in this notation. See Timecode.Timecode
+// for more information.
+//
+// If HFR is set, rates above 60 fps are written with their HFRIndex: (ex: 01:00:00:59.1).
+func (notation Notation) Timecode(tc Timecode) string {
+	multiplier, _ := notation.frameCounting(tc.rate)
+	if multiplier == 1 {
+		return notation.formatSections(tc, tc.Sections())
+	}
+
+	sections := notation.Sections(tc)
+	return fmt.Sprintf(
+		"%v%v%d", notation.formatSections(tc, sections), notation.DecimalMark, sections.HFRIndex,
+	)
+}
+
+// formatSections formats sections of tc as a timecode string in this notation.
+func (notation Notation) formatSections(tc Timecode, sections TimecodeSections) string {
+	// We'll add a negative sign if the timecode is negative.
+	sign := ""
+	if sections.IsNegative {
+		sign = "-"
+	}
+
+	// If we are using a days place, it goes before the hours.
+	days := ""
+	if tc.rollover == RolloverDays {
+		days = fmt.Sprintf("%d%v%v", sections.Days, notation.DaysUnit, notation.Separator)
+	}
+
+	return fmt.Sprintf(
+		"%v%v%02d%v%v%02d%v%v%02d%v%v%02d%v",
+		sign,
+		days,
+		sections.Hours,
+		notation.HoursUnit,
+		notation.Separator,
+		sections.Minutes,
+		notation.MinutesUnit,
+		notation.Separator,
+		sections.Seconds,
+		notation.SecondsUnit,
+		notation.frameSeparator(tc.rate),
+		sections.Frames,
+		notation.FramesUnit,
+	)
+}
+
+// Runtime returns the runtime of tc in this notation, with up to precision decimal
+// places. See Timecode.Runtime for more information.
+func (notation Notation) Runtime(tc Timecode, precision int) string {
+	seconds := tc.Seconds()
+	// If this is a negative value, make it positive for the purposes of parsing the
+	// value.
+	isNegative := tc.IsNegative()
+	if isNegative {
+		seconds.Neg(seconds)
+	}
+
+	hours, seconds := internal.DivModRat(seconds, secondsPerHourRat)
+	minutes, seconds := internal.DivModRat(seconds, secondsPerMinuteRat)
+
+	seconds = rounding.Round(seconds, precision, rounding.HalfUp)
+
+	var secondsStr string
+	if seconds.IsInt() {
+		secondsStr = fmt.Sprintf("%02d.0", seconds.Num().Int64())
+	} else {
+		secondsStr = seconds.FloatString(precision)
+		// Trim any trailing zeros.
+		secondsStr = strings.TrimRight(secondsStr, "0")
+		// If the seconds is less than 10, we need to pad a leading 0.
+		if seconds.Cmp(rat10) == -1 {
+			secondsStr = "0" + secondsStr
+		}
+	}
+	secondsStr = strings.Replace(secondsStr, ".", notation.DecimalMark, 1)
+
+	sign := ""
+	if isNegative {
+		sign = "-"
+	}
+
+	return fmt.Sprintf(
+		"%v%02d%v%v%02d%v%v%v%v",
+		sign,
+		hours.Num().Int64(),
+		notation.HoursUnit,
+		notation.Separator,
+		minutes.Num().Int64(),
+		notation.MinutesUnit,
+		notation.Separator,
+		secondsStr,
+		notation.SecondsUnit,
+	)
+}
+
+// FromTimecode parses a timecode string written in this notation. See the
+// package-level FromTimecode for more information.
+//
+// Errors are returned as a *ParseError.
+func (notation Notation) FromTimecode(tc string, framerate rate.Framerate) (Timecode, error) {
+	return ParseOptions{Notation: &notation}.FromTimecode(tc, framerate)
+}
+
+// FromRuntime parses a runtime string written in this notation. See the package-level
+// FromRuntime for more information.
+//
+// Errors are returned as a *ParseError.
+func (notation Notation) FromRuntime(runtime string, framerate rate.Framerate) (Timecode, error) {
+	pattern := notation.pattern()
+
+	match := pattern.runtime.FindStringSubmatch(runtime)
+	if match == nil {
+		err := notRecognized(
+			"expected a runtime like '%v'", notation.Runtime(exampleTimecode, 1),
+		)
+		return Timecode{}, withInput(err, runtime, FormatRuntime, nil)
+	}
+
+	return runtimeFromMatch(match, pattern, framerate), nil
+}
+
+// exampleTimecode is formatted into error messages to show the expected notation.
+var exampleTimecode = FromFrames(86400+12, rate.F24)
+
+// notationPattern holds the compiled regexes for parsing a Notation.
+type notationPattern struct {
+	// notation is the Notation the regexes were compiled from.
+	notation Notation
+	// timecode parses timecode strings. Its submatch groups line up with the
+	// timecodeRegex* index constants.
+	timecode *regexp.Regexp
+	// runtime parses runtime strings. Its submatch groups line up with the
+	// runtimeRegex* index constants.
+	runtime *regexp.Regexp
+	// byPlace is true if sections are matched by their unit rather than their position.
+	byPlace bool
+}
+
+// notationPatterns caches the compiled *notationPattern for each Notation.
+var notationPatterns = sync.Map{}
+
+// pattern returns the compiled regexes for parsing this notation.
+func (notation Notation) pattern() *notationPattern {
+	if cached, ok := notationPatterns.Load(notation); ok {
+		return cached.(*notationPattern)
+	}
+
+	pattern := notation.compile()
+	notationPatterns.Store(notation, pattern)
+	return pattern
+}
+
+// compile builds the regexes for parsing this notation.
+func (notation Notation) compile() *notationPattern {
+	frameSeps := anyOf(notation.Separator, notation.FrameSeparator, notation.DropFrameSeparator)
+
+	// Separators which double as the decimal mark are only allowed before the frames
+	// place, so field suffixes are not mistaken for another place.
+	sectionSeps := make([]string, 0, 3)
+	for _, sep := range []string{
+		notation.Separator, notation.FrameSeparator, notation.DropFrameSeparator,
+	} {
+		if sep != notation.DecimalMark {
+			sectionSeps = append(sectionSeps, sep)
+		}
+	}
+	sep := anyOf(sectionSeps...)
+	decimal := regexp.QuoteMeta(notation.DecimalMark)
+
+	section := func(unit string, separator string) string {
+		return `(([0-9]+)` + regexp.QuoteMeta(unit) + separator + `)?`
+	}
+
+	timecode := `^(-)?` +
+		section(notation.DaysUnit, sep) +
+		section(notation.HoursUnit, sep) +
+		section(notation.MinutesUnit, sep) +
+		section(notation.SecondsUnit, frameSeps) +
+		`([0-9]+)` + regexp.QuoteMeta(notation.FramesUnit) +
+		`(` + decimal + `([0-9]+)|\+([0-9]+))?$`
+
+	runtime := `^(-)?` +
+		section(notation.HoursUnit, sep) +
+		section(notation.MinutesUnit, sep) +
+		`([0-9]+(?:` + decimal + `[0-9]+)?)` + regexp.QuoteMeta(notation.SecondsUnit) + `$`
+
+	return &notationPattern{
+		notation: notation,
+		timecode: regexp.MustCompile(timecode),
+		runtime:  regexp.MustCompile(runtime),
+		byPlace:  notation.hasUnits(),
+	}
+}
+
+// anyOf returns a non-capturing regex group matching any of the non-empty values.
+func anyOf(values ...string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			quoted = append(quoted, regexp.QuoteMeta(value))
+		}
+	}
+
+	if len(quoted) == 0 {
+		return ""
+	}
+	return `(?:` + strings.Join(quoted, "|") + `)`
+}
+
+// timecodePlaces returns the submatch group holding each of the days, hours, minutes
+// and seconds places of a timecode match, or -1 for places that are not present.
+// matched reports whether a group took part in the match.
+func (pattern *notationPattern) timecodePlaces(matched func(group int) bool) []int {
+	return pattern.places(matched, []int{
+		timecodeRegexSection0, timecodeRegexSection1, timecodeRegexSection2, timecodeRegexSection3,
+	})
+}
+
+// runtimePlaces returns the submatch group holding each of the hours and minutes
+// places of a runtime match, or -1 for places that are not present.
+func (pattern *notationPattern) runtimePlaces(match []string) []int {
+	return pattern.places(
+		func(group int) bool { return match[group] != "" },
+		[]int{runtimeRegexSection1, runtimeRegexSection2},
+	)
+}
+
+// places returns the submatch group holding the place each of groups stands for, or -1
+// for places that are not present.
+func (pattern *notationPattern) places(matched func(group int) bool, groups []int) []int {
+	places := make([]int, len(groups))
+	for i := range places {
+		places[i] = -1
+	}
+
+	if pattern.byPlace {
+		for i, group := range groups {
+			if matched(group) {
+				places[i] = group
+			}
+		}
+		return places
+	}
+
+	// Without units, the places are only optionally present, and annoyingly with the
+	// way regex works, will shift what group they match to depending on which ones
+	// are present. We need to line them up from the end.
+	present := make([]int, 0, len(groups))
+	for _, group := range groups {
+		if matched(group) {
+			present = append(present, group)
+		}
+	}
+
+	for i, group := range present {
+		places[len(places)-len(present)+i] = group
+	}
+	return places
+}
+
+// frameSeparatorBounds returns the start and end byte offsets of the separator before
+// the frames place in a timecode matched with FindStringSubmatchIndex. ok is false if
+// there is no section before the frames place.
+func (pattern *notationPattern) frameSeparatorBounds(loc []int) (start int, end int, ok bool) {
+	places := pattern.timecodePlaces(func(group int) bool { return loc[2*group] >= 0 })
+
+	units := []string{
+		pattern.notation.DaysUnit,
+		pattern.notation.HoursUnit,
+		pattern.notation.MinutesUnit,
+		pattern.notation.SecondsUnit,
+	}
+
+	for i := len(places) - 1; i >= 0; i-- {
+		if places[i] == -1 {
+			continue
+		}
+		start = loc[2*places[i]+1] + len(units[i])
+		return start, loc[2*timecodeRegexFrames], true
+	}
+
+	return 0, 0, false
+}
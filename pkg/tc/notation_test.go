@@ -0,0 +1,224 @@
+package tc_test
+
+import (
+	"errors"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNotation(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Notation tc.Notation
+		Timecode tc.Timecode
+		TC       string
+		Runtime  string
+	}{
+		{
+			Name:     "SMPTE",
+			Notation: tc.NotationSMPTE,
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			TC:       "01:00:00:00",
+			Runtime:  "01:00:03.6",
+		},
+		{
+			Name:     "SMPTE Drop Frame",
+			Notation: tc.NotationSMPTE,
+			Timecode: tc.FromFrames(1800, rate.F29_97Df),
+			TC:       "00:01:00;02",
+			Runtime:  "00:01:00.06",
+		},
+		{
+			Name:     "Periods",
+			Notation: tc.NotationPeriods,
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			TC:       "01.00.00.00",
+			Runtime:  "01.00.03,6",
+		},
+		{
+			Name:     "Periods Negative",
+			Notation: tc.NotationPeriods,
+			Timecode: tc.FromFrames(-86401, rate.F24),
+			TC:       "-01.00.00.01",
+			Runtime:  "-01.00.00,041666667",
+		},
+		{
+			Name:     "Period Drop",
+			Notation: tc.NotationPeriodDrop,
+			Timecode: tc.FromFrames(1800, rate.F29_97Df),
+			TC:       "00:01:00.02",
+			Runtime:  "00:01:00.06",
+		},
+		{
+			Name:     "Period Drop NDF",
+			Notation: tc.NotationPeriodDrop,
+			Timecode: tc.FromFrames(1800, rate.F29_97Ndf),
+			TC:       "00:01:00:00",
+			Runtime:  "00:01:00.06",
+		},
+		{
+			Name:     "SRT",
+			Notation: tc.NotationSRT,
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			TC:       "01:00:00:00",
+			Runtime:  "01:00:03,6",
+		},
+		{
+			Name:     "Units",
+			Notation: tc.NotationUnits,
+			Timecode: tc.FromFrames(86400+24*62+3, rate.F24),
+			TC:       "01h01m02s03i",
+			Runtime:  "01h01m02,125s",
+		},
+		{
+			Name:     "Units Days",
+			Notation: tc.NotationUnits,
+			Timecode: tc.FromFrames(86400*25, rate.F24).WithRollover(tc.RolloverDays),
+			TC:       "1d01h00m00s00i",
+			Runtime:  "25h00m00,0s",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			notation := testCase.Notation
+			framerate := testCase.Timecode.Rate()
+
+			assert.Equal(t, testCase.TC, notation.Timecode(testCase.Timecode), "timecode")
+			assert.Equal(t, testCase.Runtime, notation.Runtime(testCase.Timecode, 9), "runtime")
+
+			parsed, err := notation.FromTimecode(testCase.TC, framerate)
+			if assert.NoError(t, err, "parse timecode") {
+				assert.Equal(t, testCase.Timecode.Frames(), parsed.Frames(), "parsed timecode")
+			}
+
+			parsed, err = notation.FromRuntime(testCase.Runtime, framerate)
+			if assert.NoError(t, err, "parse runtime") {
+				assert.Equal(t, testCase.Timecode.Frames(), parsed.Frames(), "parsed runtime")
+			}
+		})
+	}
+}
+
+func TestNotation_FromTimecode_Partial(t *testing.T) {
+	cases := []struct {
+		Notation tc.Notation
+		In       string
+		Rate     rate.Framerate
+		Expected string
+	}{
+		{Notation: tc.NotationPeriods, In: "3.04", Rate: rate.F24, Expected: "00:00:03:04.0"},
+		{Notation: tc.NotationPeriods, In: "01.00.00.00,1", Rate: rate.F24, Expected: "01:00:00:00.1"},
+		{Notation: tc.NotationPeriodDrop, In: "01:00.02", Rate: rate.F29_97Df, Expected: "00:01:00;02.0"},
+		{Notation: tc.NotationUnits, In: "3s04i", Rate: rate.F24, Expected: "00:00:03:04.0"},
+		{Notation: tc.NotationUnits, In: "1h04i", Rate: rate.F24, Expected: "01:00:00:04.0"},
+		{Notation: tc.NotationUnits, In: "-2m00s00i", Rate: rate.F24, Expected: "-00:02:00:00.0"},
+		{Notation: tc.NotationSRT, In: "01:00:00:00", Rate: rate.F24, Expected: "01:00:00:00.0"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.In, func(t *testing.T) {
+			parsed, err := testCase.Notation.FromTimecode(testCase.In, testCase.Rate)
+			if assert.NoError(t, err) {
+				assert.Equal(t, testCase.Expected, parsed.TimecodeField())
+			}
+		})
+	}
+}
+
+func TestNotation_Errors(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Parse  func() error
+		Field  string
+		Offset int
+		Err    error
+	}{
+		{
+			Name: "SMPTE Rejects Pipe",
+			Parse: func() error {
+				_, err := tc.FromTimecode("01|00|00|00", rate.F24)
+				return err
+			},
+			Offset: -1,
+			Err:    tc.ErrFormatNotRecognized,
+		},
+		{
+			Name: "Periods Rejects Colons",
+			Parse: func() error {
+				_, err := tc.NotationPeriods.FromTimecode("01:00:00:00", rate.F24)
+				return err
+			},
+			Offset: -1,
+			Err:    tc.ErrFormatNotRecognized,
+		},
+		{
+			Name: "Units Missing Frames Unit",
+			Parse: func() error {
+				_, err := tc.NotationUnits.FromTimecode("01h00m00s00", rate.F24)
+				return err
+			},
+			Offset: -1,
+			Err:    tc.ErrFormatNotRecognized,
+		},
+		{
+			Name: "Units Runtime",
+			Parse: func() error {
+				_, err := tc.NotationUnits.FromRuntime("01:00:00.5", rate.F24)
+				return err
+			},
+			Offset: -1,
+			Err:    tc.ErrFormatNotRecognized,
+		},
+		{
+			Name: "Strict Period Drop Separator",
+			Parse: func() error {
+				opts := tc.StrictParseOptions
+				opts.Notation = &tc.NotationPeriodDrop
+				_, err := opts.FromTimecode("00:01:00:02", rate.F29_97Df)
+				return err
+			},
+			Field:  "separator",
+			Offset: 8,
+			Err:    tc.ErrDropSeparatorMismatch,
+		},
+		{
+			Name: "Strict Units Missing Sections",
+			Parse: func() error {
+				opts := tc.StrictParseOptions
+				opts.Notation = &tc.NotationUnits
+				_, err := opts.FromTimecode("1d01h00m05i", rate.F24)
+				return err
+			},
+			Offset: -1,
+			Err:    tc.ErrMissingSections,
+		},
+		{
+			Name: "Strict Units Overflow",
+			Parse: func() error {
+				opts := tc.StrictParseOptions
+				opts.Notation = &tc.NotationUnits
+				_, err := opts.FromTimecode("01h75m00s00i", rate.F24)
+				return err
+			},
+			Field:  "minutes",
+			Offset: 3,
+			Err:    tc.ErrSectionOverflow,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Parse()
+			assert.ErrorIs(t, err, testCase.Err)
+
+			var parseErr *tc.ParseError
+			if assert.True(t, errors.As(err, &parseErr), "is ParseError") {
+				assert.Equal(t, testCase.Field, parseErr.Field, "field")
+				assert.Equal(t, testCase.Offset, parseErr.Offset, "offset")
+			}
+		})
+	}
+}
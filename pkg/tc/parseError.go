@@ -0,0 +1,179 @@
+package tc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseError is returned by the string parsers in this package. It records the input
+// that could not be parsed and what was wrong with it, and wraps one of the package's
+// sentinel errors so it can still be checked with errors.Is.
+type ParseError struct {
+	// Input is the string that could not be parsed.
+	Input string
+	// Format is the format Input was being parsed as, or FormatUnknown if no format
+	// could be detected.
+	Format Format
+	// Field is the name of the place in Input at fault, like "minutes", "frames" or
+	// "separator". It is empty when the error is not specific to a single place.
+	Field string
+	// Offset is the byte offset of Field in Input, or -1 if the error is not specific to
+	// a position in Input.
+	Offset int
+	// Reason is a human-readable explanation of what was wrong with Input.
+	Reason string
+	// Err is the sentinel error being wrapped, like ErrSectionOverflow.
+	Err error
+}
+
+// Error implements error.
+//
+// Ex: could not parse Timecode: section value overflows its place: frames field 31
+// must be less than timebase 30 (timecode "00:00:00:31", byte 9)
+func (err *ParseError) Error() string {
+	builder := strings.Builder{}
+	builder.WriteString(err.Err.Error())
+	if err.Reason != "" {
+		builder.WriteString(": ")
+		builder.WriteString(err.Reason)
+	}
+
+	format := "input"
+	if err.Format != FormatUnknown {
+		format = err.Format.String()
+	}
+	builder.WriteString(fmt.Sprintf(" (%v %q", format, err.Input))
+
+	if err.Offset >= 0 {
+		builder.WriteString(fmt.Sprintf(", byte %d", err.Offset))
+	}
+	builder.WriteString(")")
+
+	return builder.String()
+}
+
+// Unwrap returns Err, so ParseError values can be checked against this package's
+// sentinel errors with errors.Is.
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
+
+// Names of the places in a string that a ParseError can blame.
+const (
+	fieldDays      = "days"
+	fieldHours     = "hours"
+	fieldMinutes   = "minutes"
+	fieldSeconds   = "seconds"
+	fieldFrames    = "frames"
+	fieldField     = "field"
+	fieldSubframes = "subframes"
+	fieldHFRIndex  = "hfr index"
+	fieldSeparator = "separator"
+	fieldFeet      = "feet"
+	fieldPerfs     = "perfs"
+	fieldFootage   = "footage"
+	fieldRollover  = "rollover"
+)
+
+// fieldError returns a new *ParseError wrapping sentinel that blames field. The Input,
+// Format and Offset will be filled in by withInput in the exported parser that
+// returns it.
+func fieldError(sentinel error, field string, reason string, args ...interface{}) *ParseError {
+	return &ParseError{
+		Field:  field,
+		Offset: -1,
+		Reason: fmt.Sprintf(reason, args...),
+		Err:    sentinel,
+	}
+}
+
+// notRecognized returns a new *ParseError wrapping ErrFormatNotRecognized.
+func notRecognized(reason string, args ...interface{}) *ParseError {
+	return fieldError(ErrFormatNotRecognized, "", reason, args...)
+}
+
+// withInput returns a copy of err as a *ParseError for input parsed as format. If the
+// error blames a field, its Offset is looked up in offsets. Errors that are not already
+// a *ParseError are wrapped in one.
+func withInput(err error, input string, format Format, offsets map[string]int) error {
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		parseErr = &ParseError{Offset: -1, Err: err}
+	}
+
+	result := *parseErr
+	result.Input = input
+	result.Format = format
+
+	if offset, ok := offsets[result.Field]; ok && result.Field != "" {
+		result.Offset = offset
+	}
+
+	return &result
+}
+
+// timecodeOffsets returns the byte offset of each field in a timecode string matched
+// by pattern.
+func timecodeOffsets(tc string, pattern *notationPattern) map[string]int {
+	offsets := make(map[string]int)
+
+	loc := pattern.timecode.FindStringSubmatchIndex(tc)
+	if loc == nil {
+		return offsets
+	}
+
+	places := pattern.timecodePlaces(func(group int) bool { return loc[2*group] >= 0 })
+	names := []string{fieldDays, fieldHours, fieldMinutes, fieldSeconds}
+	for i, group := range places {
+		if group != -1 {
+			offsets[names[i]] = loc[2*group]
+		}
+	}
+
+	offsets[fieldFrames] = loc[2*timecodeRegexFrames]
+	if start, _, ok := pattern.frameSeparatorBounds(loc); ok {
+		offsets[fieldSeparator] = start
+	}
+
+	if start := loc[2*timecodeRegexField]; start >= 0 {
+		offsets[fieldField] = start
+		offsets[fieldHFRIndex] = start
+	}
+	if start := loc[2*timecodeRegexSubframes]; start >= 0 {
+		offsets[fieldSubframes] = start
+	}
+
+	return offsets
+}
+
+// feetAndFramesOffsets returns the byte offset of each field in a feet+frames string.
+// framesField is the name of the place after the '+'.
+func feetAndFramesOffsets(faf string, framesField string) map[string]int {
+	offsets := make(map[string]int)
+
+	loc := feetAndFramesRegex.FindStringSubmatchIndex(faf)
+	if loc == nil {
+		return offsets
+	}
+
+	offsets[fieldFeet] = loc[2*fafRegexFeet]
+	offsets[framesField] = loc[2*fafRegexFrames]
+
+	return offsets
+}
+
+// keyKodeOffsets returns the byte offset of each field in a KeyKode string.
+func keyKodeOffsets(value string) map[string]int {
+	offsets := make(map[string]int)
+
+	loc := keyKodeRegex.FindStringSubmatchIndex(value)
+	if loc == nil {
+		return offsets
+	}
+
+	offsets[fieldFootage] = loc[2*keyKodeRegexFootage]
+	offsets[fieldFrames] = loc[2*keyKodeRegexFrames]
+
+	return offsets
+}
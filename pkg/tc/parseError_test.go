@@ -0,0 +1,218 @@
+package tc_test
+
+import (
+	"errors"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Parse  func(value string) error
+		Input  string
+		Format tc.Format
+		Field  string
+		Offset int
+		Err    error
+	}{
+		{
+			Name: "Timecode Not Recognized",
+			Parse: func(value string) error {
+				_, err := tc.FromTimecode(value, rate.F24)
+				return err
+			},
+			Input:  "not a timecode",
+			Format: tc.FormatTimecode,
+			Offset: -1,
+			Err:    tc.ErrFormatNotRecognized,
+		},
+		{
+			Name: "Drop Frame Value",
+			Parse: func(value string) error {
+				_, err := tc.FromTimecode(value, rate.F29_97Df)
+				return err
+			},
+			Input:  "-00:01:00;01",
+			Format: tc.FormatTimecode,
+			Field:  "frames",
+			Offset: 10,
+			Err:    tc.ErrBadDropFrameValue,
+		},
+		{
+			Name: "Minutes Overflow",
+			Parse: func(value string) error {
+				_, err := tc.FromTimecodeStrict(value, rate.F24)
+				return err
+			},
+			Input:  "00:75:00:00",
+			Format: tc.FormatTimecode,
+			Field:  "minutes",
+			Offset: 3,
+			Err:    tc.ErrSectionOverflow,
+		},
+		{
+			Name: "Days Hours Overflow",
+			Parse: func(value string) error {
+				_, err := tc.FromTimecodeStrict(value, rate.F24)
+				return err
+			},
+			Input:  "1:24:00:00:00",
+			Format: tc.FormatTimecode,
+			Field:  "hours",
+			Offset: 2,
+			Err:    tc.ErrSectionOverflow,
+		},
+		{
+			Name: "Separator",
+			Parse: func(value string) error {
+				_, err := tc.FromTimecodeStrict(value, rate.F29_97Df)
+				return err
+			},
+			Input:  "00:01:00:02",
+			Format: tc.FormatTimecode,
+			Field:  "separator",
+			Offset: 8,
+			Err:    tc.ErrDropSeparatorMismatch,
+		},
+		{
+			Name: "Missing Sections",
+			Parse: func(value string) error {
+				_, err := tc.FromTimecodeStrict(value, rate.F24)
+				return err
+			},
+			Input:  "00:00:04",
+			Format: tc.FormatTimecode,
+			Offset: -1,
+			Err:    tc.ErrMissingSections,
+		},
+		{
+			Name: "Subframes",
+			Parse: func(value string) error {
+				_, err := tc.FromTimecode(value, rate.F24)
+				return err
+			},
+			Input:  "01:00:00:00+100",
+			Format: tc.FormatTimecode,
+			Field:  "subframes",
+			Offset: 12,
+			Err:    tc.ErrBadSubframeValue,
+		},
+		{
+			Name: "HFR Index",
+			Parse: func(value string) error {
+				_, err := tc.NotationHFR.FromTimecode(value, mustRate(120, rate.NTSCNone))
+				return err
+			},
+			Input:  "01:00:00:00.2",
+			Format: tc.FormatTimecode,
+			Field:  "hfr index",
+			Offset: 12,
+			Err:    tc.ErrBadSubframeValue,
+		},
+		{
+			Name: "Runtime Not Recognized",
+			Parse: func(value string) error {
+				_, err := tc.FromRuntime(value, rate.F24)
+				return err
+			},
+			Input:  "1.2.3",
+			Format: tc.FormatRuntime,
+			Offset: -1,
+			Err:    tc.ErrFormatNotRecognized,
+		},
+		{
+			Name: "Perfs",
+			Parse: func(value string) error {
+				_, err := tc.FromFeetAndPerfsFor(value, tc.Film35mm4Perf, rate.F24)
+				return err
+			},
+			Input:  "10+03",
+			Format: tc.FormatFeetAndFrames,
+			Field:  "perfs",
+			Offset: 3,
+			Err:    tc.ErrBadPerfValue,
+		},
+		{
+			Name: "KeyKode Not Recognized",
+			Parse: func(value string) error {
+				_, err := tc.ParseKeyKode(value, tc.Film35mm4Perf)
+				return err
+			},
+			Input:  "KJ 23 1234 5678",
+			Format: tc.FormatUnknown,
+			Offset: -1,
+			Err:    tc.ErrParseKeyKode,
+		},
+		{
+			Name: "KeyKode Frames",
+			Parse: func(value string) error {
+				_, err := tc.ParseKeyKode(value, tc.Film35mm4Perf)
+				return err
+			},
+			Input:  "KJ 23 1234 5678+16",
+			Format: tc.FormatUnknown,
+			Field:  "frames",
+			Offset: 16,
+			Err:    tc.ErrParseKeyKode,
+		},
+		{
+			Name: "Tick Base",
+			Parse: func(value string) error {
+				_, err := tc.ParseTickBase(value)
+				return err
+			},
+			Input:  "-1/1000",
+			Format: tc.FormatUnknown,
+			Offset: -1,
+			Err:    tc.ErrBadTickBase,
+		},
+		{
+			Name: "Ambiguous",
+			Parse: func(value string) error {
+				_, _, err := tc.Parse(value, rate.F24)
+				return err
+			},
+			Input:  "10584000000",
+			Format: tc.FormatUnknown,
+			Offset: -1,
+			Err:    tc.ErrAmbiguousFormat,
+		},
+		{
+			Name: "With Rate",
+			Parse: func(value string) error {
+				_, err := tc.ParseWithRate(value)
+				return err
+			},
+			Input:  "01:00:00;00 @ 29.97 NTSC DF x",
+			Format: tc.FormatTimecode,
+			Offset: -1,
+			Err:    rate.ErrParseFramerate,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			err := testCase.Parse(testCase.Input)
+			assert.ErrorIs(err, testCase.Err, "sentinel")
+
+			var parseErr *tc.ParseError
+			if errors.Is(err, tc.ErrParseTimecode) && !assert.ErrorAs(err, &parseErr) {
+				t.FailNow()
+			}
+			if parseErr == nil {
+				return
+			}
+
+			assert.Equal(testCase.Input, parseErr.Input, "input")
+			assert.Equal(testCase.Format, parseErr.Format, "format")
+			assert.Equal(testCase.Field, parseErr.Field, "field")
+			assert.Equal(testCase.Offset, parseErr.Offset, "offset")
+			assert.NotEmpty(parseErr.Reason, "reason")
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package tc
+
+import (
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"math/big"
+)
+
+// ParseOptions configures how strictly timecode strings are checked when parsing. The
+// zero value matches the behavior of the package-level FromTimecode, which accepts and
+// normalizes any value it can make sense of.
+type ParseOptions struct {
+	// RejectOverflow returns ErrSectionOverflow when the minutes or seconds place is 60
+	// or more, the frames place is at or above the timebase, or the hours place is 24
+	// or more when a days place is present.
+	RejectOverflow bool
+
+	// RequireAllSections returns ErrMissingSections when the hours, minutes, seconds and
+	// frames places are not all present. By default, partial values like '3:04' are
+	// parsed as '00:00:03:04'.
+	RequireAllSections bool
+
+	// MatchDropSeparator returns ErrDropSeparatorMismatch when the separator before the
+	// frames place does not match the drop-frame status of the framerate: the
+	// Notation's DropFrameSeparator for drop-frame, and its FrameSeparator for
+	// everything else.
+	MatchDropSeparator bool
+
+	// Notation is the Notation timecode strings are written in. If nil, NotationSMPTE
+	// is used.
+	Notation *Notation
+}
+
+// notation returns the Notation set in opts, or NotationSMPTE if none is set.
+func (opts ParseOptions) notation() Notation {
+	if opts.Notation == nil {
+		return NotationSMPTE
+	}
+	return *opts.Notation
+}
+
+// StrictParseOptions enables every check in ParseOptions. It is used by
+// FromTimecodeStrict.
+var StrictParseOptions = ParseOptions{
+	RejectOverflow:     true,
+	RequireAllSections: true,
+	MatchDropSeparator: true,
+}
+
+// FromTimecodeStrict parses a new timecode value from a string, like FromTimecode,
+// but returns an error instead of normalizing out-of-range sections, partial values,
+// or separators that do not match the drop-frame status of framerate.
+//
+// This is useful for catching typos in hand-entered data, like EDLs and spreadsheets.
+func FromTimecodeStrict(tc string, framerate rate.Framerate) (Timecode, error) {
+	return StrictParseOptions.FromTimecode(tc, framerate)
+}
+
+// sectionsRequired is the number of separated sections before the frames place that
+// RequireAllSections expects: hours, minutes and seconds.
+const sectionsRequired = 3
+
+// check returns an error if a matched timecode string does not pass the checks
+// enabled in opts.
+func (opts ParseOptions) check(
+	tc string,
+	match []string,
+	sections TimecodeSections,
+	framerate rate.Framerate,
+	pattern *notationPattern,
+) error {
+	places := pattern.timecodePlaces(func(group int) bool { return match[group] != "" })
+
+	if opts.RequireAllSections {
+		if err := checkAllSections(places); err != nil {
+			return err
+		}
+	}
+
+	if opts.RejectOverflow {
+		hasDays := places[0] != -1
+		_, timebase := pattern.notation.frameCounting(framerate)
+		if err := checkOverflow(sections, hasDays, timebase); err != nil {
+			return err
+		}
+	}
+
+	if opts.MatchDropSeparator {
+		if err := checkDropSeparator(tc, framerate, pattern); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkAllSections returns ErrMissingSections if the places of a timecode match do not
+// include an hours, minutes and seconds place.
+func checkAllSections(places []int) error {
+	found := 0
+	for _, group := range places[len(places)-sectionsRequired:] {
+		if group != -1 {
+			found++
+		}
+	}
+
+	if found < sectionsRequired {
+		return fieldError(
+			ErrMissingSections,
+			"",
+			"found %v sections before frames, expected %v",
+			found,
+			sectionsRequired,
+		)
+	}
+	return nil
+}
+
+// checkOverflow returns ErrSectionOverflow if any section is too large for its place.
+func checkOverflow(sections TimecodeSections, hasDays bool, timebase *big.Rat) error {
+	if hasDays && sections.Hours >= hoursPerDay {
+		return fieldError(
+			ErrSectionOverflow,
+			fieldHours,
+			"hours field %v must be less than %v",
+			sections.Hours,
+			hoursPerDay,
+		)
+	}
+
+	if sections.Minutes >= minutesPerHour {
+		return fieldError(
+			ErrSectionOverflow,
+			fieldMinutes,
+			"minutes field %v must be less than %v",
+			sections.Minutes,
+			minutesPerHour,
+		)
+	}
+
+	if sections.Seconds >= secondsPerMinute {
+		return fieldError(
+			ErrSectionOverflow,
+			fieldSeconds,
+			"seconds field %v must be less than %v",
+			sections.Seconds,
+			secondsPerMinute,
+		)
+	}
+
+	if big.NewRat(sections.Frames, 1).Cmp(timebase) >= 0 {
+		return fieldError(
+			ErrSectionOverflow,
+			fieldFrames,
+			"frames field %v must be less than timebase %v",
+			sections.Frames,
+			timebase.RatString(),
+		)
+	}
+
+	return nil
+}
+
+// checkDropSeparator returns ErrDropSeparatorMismatch if the separator before the
+// frames place of tc does not match the drop-frame status of framerate. Values without
+// a frames separator are not checked.
+func checkDropSeparator(tc string, framerate rate.Framerate, pattern *notationPattern) error {
+	loc := pattern.timecode.FindStringSubmatchIndex(tc)
+	start, end, ok := pattern.frameSeparatorBounds(loc)
+	if !ok {
+		return nil
+	}
+
+	found := tc[start:end]
+	expected := pattern.notation.frameSeparator(framerate)
+
+	if found != expected {
+		return fieldError(
+			ErrDropSeparatorMismatch,
+			fieldSeparator,
+			"frames separator '%v' should be '%v' for %v",
+			found,
+			expected,
+			framerate,
+		)
+	}
+
+	return nil
+}
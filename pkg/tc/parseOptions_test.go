@@ -0,0 +1,133 @@
+package tc_test
+
+import (
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFromTimecodeStrict(t *testing.T) {
+	cases := []struct {
+		In          string
+		Rate        rate.Framerate
+		Expected    string
+		ExpectedErr error
+	}{
+		{
+			In:       "01:00:00:23",
+			Rate:     rate.F24,
+			Expected: "01:00:00:23",
+		},
+		{
+			In:       "-01:00:00:23",
+			Rate:     rate.F24,
+			Expected: "-01:00:00:23",
+		},
+		{
+			In:       "00:01:00;02",
+			Rate:     rate.F29_97Df,
+			Expected: "00:01:00;02",
+		},
+		{
+			In:       "1:23:59:59:23",
+			Rate:     rate.F24,
+			Expected: "47:59:59:23",
+		},
+		{
+			In:       "01:00:00:23+00",
+			Rate:     rate.F24,
+			Expected: "01:00:00:23",
+		},
+		{
+			In:          "00:00:00:30",
+			Rate:        rate.F24,
+			ExpectedErr: tc.ErrSectionOverflow,
+		},
+		{
+			In:          "00:00:00:24",
+			Rate:        rate.F23_98,
+			ExpectedErr: tc.ErrSectionOverflow,
+		},
+		{
+			In:          "00:00:60:00",
+			Rate:        rate.F24,
+			ExpectedErr: tc.ErrSectionOverflow,
+		},
+		{
+			In:          "00:75:00:00",
+			Rate:        rate.F24,
+			ExpectedErr: tc.ErrSectionOverflow,
+		},
+		{
+			In:          "1:24:00:00:00",
+			Rate:        rate.F24,
+			ExpectedErr: tc.ErrSectionOverflow,
+		},
+		{
+			In:          "00:00:04",
+			Rate:        rate.F24,
+			ExpectedErr: tc.ErrMissingSections,
+		},
+		{
+			In:          "4",
+			Rate:        rate.F24,
+			ExpectedErr: tc.ErrMissingSections,
+		},
+		{
+			In:          "00:01:00:02",
+			Rate:        rate.F29_97Df,
+			ExpectedErr: tc.ErrDropSeparatorMismatch,
+		},
+		{
+			In:          "01:00:00;00",
+			Rate:        rate.F29_97Ndf,
+			ExpectedErr: tc.ErrDropSeparatorMismatch,
+		},
+		{
+			In:          "00:01:00;00",
+			Rate:        rate.F29_97Df,
+			ExpectedErr: tc.ErrBadDropFrameValue,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.In+" "+testCase.Rate.String(), func(t *testing.T) {
+			assert := assert.New(t)
+
+			timecode, err := tc.FromTimecodeStrict(testCase.In, testCase.Rate)
+			if testCase.ExpectedErr != nil {
+				assert.ErrorIs(err, tc.ErrParseTimecode, "is parse err")
+				assert.ErrorIs(err, testCase.ExpectedErr, "is correct sub err")
+				return
+			}
+
+			if !assert.NoError(err, "parse In") {
+				t.FailNow()
+			}
+			assert.Equal(testCase.Expected, timecode.Timecode(), "timecode")
+		})
+	}
+}
+
+func TestParseOptions_Individual(t *testing.T) {
+	assert := assert.New(t)
+
+	// Each option should only enable its own check.
+	overflowOnly := tc.ParseOptions{RejectOverflow: true}
+	_, err := overflowOnly.FromTimecode("3:04", rate.F29_97Df)
+	assert.NoError(err, "partial allowed")
+	_, err = overflowOnly.FromTimecode("00:00:03:30", rate.F29_97Df)
+	assert.ErrorIs(err, tc.ErrSectionOverflow, "overflow rejected")
+
+	sectionsOnly := tc.ParseOptions{RequireAllSections: true}
+	timecode, err := sectionsOnly.FromTimecode("00:00:00:48", rate.F24)
+	assert.NoError(err, "overflow allowed")
+	assert.Equal("00:00:02:00", timecode.Timecode(), "overflow normalized")
+
+	separatorOnly := tc.ParseOptions{MatchDropSeparator: true}
+	_, err = separatorOnly.FromTimecode("00:00:62;00", rate.F29_97Df)
+	assert.NoError(err, "overflow allowed")
+	_, err = separatorOnly.FromTimecode("00:00:02:00", rate.F29_97Df)
+	assert.ErrorIs(err, tc.ErrDropSeparatorMismatch, "separator rejected")
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 // FromSeconds creates a new Timecode based on a rational representation of the
@@ -34,22 +35,29 @@ func FromSeconds(seconds *big.Rat, framerate rate.Framerate) Timecode {
 	}
 }
 
-// timecodeRegex is the regex we are going to use to parse timecode.
-var timecodeRegex = regexp.MustCompile(
-	`^(?P<negative>-)?` +
-		`((?P<section1>[0-9]+)[:|;])?` +
-		`((?P<section2>[0-9]+)[:|;])?` +
-		`((?P<section3>[0-9]+)[:|;])?` +
-		`(?P<frames>[0-9]+)$`,
-)
+// FromSecondsExact creates a new Timecode from a rational seconds count, without
+// rounding it to the nearest frame.
+//
+// Values that fall between two frames keep their exact sub-frame position, but will
+// report the nearest whole frame from methods like Frames and Timecode. See
+// Timecode.Subframes for more information.
+func FromSecondsExact(seconds *big.Rat, framerate rate.Framerate) Timecode {
+	return Timecode{
+		seconds: new(big.Rat).Set(seconds),
+		rate:    framerate,
+	}
+}
 
-// Indexes of our submatch groups.
+// Indexes of the submatch groups of a timecode regex compiled by Notation.compile.
 const (
-	timecodeRegexNegative = 1
-	timecodeRegexSection1 = 3
-	timecodeRegexSection2 = 5
-	timecodeRegexSection3 = 7
-	timecodeRegexFrames   = 8
+	timecodeRegexNegative  = 1
+	timecodeRegexSection0  = 3
+	timecodeRegexSection1  = 5
+	timecodeRegexSection2  = 7
+	timecodeRegexSection3  = 9
+	timecodeRegexFrames    = 10
+	timecodeRegexField     = 12
+	timecodeRegexSubframes = 13
 )
 
 // FromFrames converts a frame count / number to a Timecode value.
@@ -63,15 +71,190 @@ func FromFrames(frames int64, framerate rate.Framerate) Timecode {
 	return FromSeconds(seconds, framerate)
 }
 
+// fromFramesRat converts a possibly fractional frame count to a Timecode value without
+// rounding it to the nearest whole frame.
+func fromFramesRat(frames *big.Rat, framerate rate.Framerate) Timecode {
+	playback := framerate.Playback()
+	seconds := playback.Quo(frames, playback)
+
+	return Timecode{
+		seconds: seconds,
+		rate:    framerate,
+	}
+}
+
+// FromFields converts a count of interlaced video fields to a Timecode value. Every
+// frame is made up of two fields.
+func FromFields(fields int64, framerate rate.Framerate) Timecode {
+	return fromFramesRat(big.NewRat(fields, fieldsPerFrame), framerate)
+}
+
+// FromSubframes converts a count of subframes, where each frame is divided into
+// divisions subframes, to a Timecode value.
+//
+// The Timecode will keep its exact sub-frame position, but will report the nearest
+// whole frame from methods like Frames and Timecode.
+func FromSubframes(subframes int64, divisions int64, framerate rate.Framerate) Timecode {
+	return fromFramesRat(big.NewRat(subframes, divisions), framerate)
+}
+
 // FromTimecode parses a new timecode value from a string.
+//
+// An optional days place may be included before the hours, like '1:00:00:00:00', as
+// formatted by a Timecode using RolloverDays. The returned value will always use
+// RolloverNone; call Timecode.WithRollover to change it.
+//
+// The frames place may be followed by a field suffix, like '01:00:00:00.1', or a
+// Pro Tools-style subframe suffix of SubframesPerFrame divisions, like '01:00:00:00+50'.
+// See Timecode.TimecodeField and Timecode.TimecodeSubframes for more information. To
+// parse SMPTE ST 12-3 high-frame-rate timecode, where '.1' is an HFRIndex instead of a
+// field, use NotationHFR.
+//
+// Out-of-range values are normalized rather than rejected, so '00:00:00:30' at 24 fps
+// will parse as '00:00:01:06'. Use FromTimecodeStrict or ParseOptions to reject them
+// instead.
+//
+// Values are parsed in NotationSMPTE. Use a Notation to parse other dialects, like
+// '01.00.00.00' or '01h00m00s00i'.
 func FromTimecode(tc string, framerate rate.Framerate) (Timecode, error) {
+	return ParseOptions{}.FromTimecode(tc, framerate)
+}
+
+// FromTimecode parses a new timecode value from a string, checking it against opts.
+// See the package-level FromTimecode for the formats accepted.
+//
+// Errors are returned as a *ParseError.
+func (opts ParseOptions) FromTimecode(tc string, framerate rate.Framerate) (Timecode, error) {
+	timecode, err := opts.fromTimecode(tc, framerate)
+	if err != nil {
+		return Timecode{}, withInput(
+			err, tc, FormatTimecode, timecodeOffsets(tc, opts.notation().pattern()),
+		)
+	}
+	return timecode, nil
+}
+
+// fromTimecode implements ParseOptions.FromTimecode without filling in ParseError
+// details.
+func (opts ParseOptions) fromTimecode(tc string, framerate rate.Framerate) (Timecode, error) {
+	pattern := opts.notation().pattern()
+
 	// See if our regex gets a match
-	match := timecodeRegex.FindStringSubmatch(tc)
+	match := pattern.timecode.FindStringSubmatch(tc)
 	if match == nil {
-		return Timecode{}, ErrFormatNotRecognized
+		return Timecode{}, notRecognized(
+			"expected a timecode like '%v'", pattern.notation.Timecode(exampleTimecode),
+		)
+	}
+
+	sections := tcSectionsFromMatch(match, pattern)
+	if err := opts.check(tc, match, sections, framerate, pattern); err != nil {
+		return Timecode{}, err
 	}
 
-	sections := tcSectionsFromMatch(match)
+	multiplier, baseTimebase := pattern.notation.frameCounting(framerate)
+	frames, err := framesFromSections(
+		sections, baseTimebase, framerate.NTSC() == rate.NTSCDrop,
+	)
+	if err != nil {
+		return Timecode{}, err
+	}
+
+	// Add any partial frame from a field or subframe suffix, or the HFRIndex for HFR
+	// notations.
+	var partial *big.Rat
+	if pattern.notation.HFR {
+		index, err := hfrIndexFromMatch(match, multiplier, framerate)
+		if err != nil {
+			return Timecode{}, err
+		}
+		partial = big.NewRat(index, 1)
+	} else if partial, err = partialFrameFromMatch(match); err != nil {
+		return Timecode{}, err
+	}
+	framesRat := partial.Add(partial, big.NewRat(frames*multiplier, 1))
+
+	// If this was a negative value, we need to make the frames negative.
+	isNegative := match[timecodeRegexNegative] != ""
+	if isNegative {
+		framesRat.Neg(framesRat)
+	}
+
+	return fromFramesRat(framesRat, framerate), nil
+}
+
+// rateSeparator separates the timecode and framerate in the output of Timecode.String.
+const rateSeparator = " @ "
+
+// ParseWithRate parses a timecode and its framerate from the form returned by
+// Timecode.String, like '01:00:00:00 @ 23.98 NTSC NDF'.
+//
+// The framerate is parsed with rate.ParseDisplay, and errors from it are returned
+// as-is, wrapping rate.ErrParseFramerate.
+//
+// If the timecode includes a days place, the returned value will use RolloverDays so
+// that it formats the same way it was written.
+func ParseWithRate(value string) (Timecode, error) {
+	separatorIndex := strings.LastIndex(value, rateSeparator)
+	if separatorIndex == -1 {
+		err := notRecognized(
+			"no '%v' separator found between timecode and framerate",
+			strings.TrimSpace(rateSeparator),
+		)
+		return Timecode{}, withInput(err, value, FormatTimecode, nil)
+	}
+
+	framerate, err := rate.ParseDisplay(value[separatorIndex+len(rateSeparator):])
+	if err != nil {
+		return Timecode{}, err
+	}
+
+	tcStr := value[:separatorIndex]
+	timecode, err := FromTimecode(tcStr, framerate)
+	if err != nil {
+		// The timecode is at the start of value, so the offsets still line up.
+		return Timecode{}, withInput(
+			err, value, FormatTimecode, timecodeOffsets(tcStr, NotationSMPTE.pattern()),
+		)
+	}
+
+	if strings.Count(tcStr, ":")+strings.Count(tcStr, ";") > sectionsRequired {
+		timecode = timecode.WithRollover(RolloverDays)
+	}
+
+	return timecode, nil
+}
+
+// InferDropFrame returns framerate as a drop-frame rate if tc uses the drop-frame ';'
+// separator, like '01:00:00;00'. Otherwise, framerate is returned unchanged, since
+// some tools write drop-frame timecode with ':' separators.
+//
+// Drop-frame is always NTSC, so a 30 fps framerate will become 29.97 NTSC DF.
+//
+// An error wrapping rate.ErrBadDropFrameRate is returned if tc uses ';' but
+// framerate cannot be drop-frame.
+func InferDropFrame(tc string, framerate rate.Framerate) (rate.Framerate, error) {
+	if !strings.Contains(tc, ";") || framerate.NTSC() == rate.NTSCDrop {
+		return framerate, nil
+	}
+
+	return dropFrameRate(framerate)
+}
+
+// dropFrameRate returns the drop-frame version of framerate, keeping its ScanType.
+func dropFrameRate(framerate rate.Framerate) (rate.Framerate, error) {
+	dropRate, err := rate.FromRat(framerate.Playback(), rate.NTSCDrop)
+	if err != nil {
+		return rate.Framerate{}, err
+	}
+	return dropRate.WithScanType(framerate.ScanType())
+}
+
+// framesFromSections returns the positive frame count of sections at timebase.
+func framesFromSections(sections TimecodeSections, timebase *big.Rat, isDrop bool) (int64, error) {
+	// Days can be rolled into the hours place, which is unbounded.
+	sections.Hours += sections.Days * hoursPerDay
+	sections.Days = 0
 
 	// Now we need to get the seconds as a rational value so we can multiply it by our
 	// timebase.
@@ -81,55 +264,71 @@ func FromTimecode(tc string, framerate rate.Framerate) (Timecode, error) {
 	// We are going to calculate our frames as a rational. We multiply our seconds by
 	// our timebase then add the frames as a rational value to it.
 	framesRat := big.NewRat(sections.Frames, 1)
-	framesRat = secondsRat.Mul(secondsRat, framerate.Timebase()).Add(secondsRat, framesRat)
+	framesRat = secondsRat.Mul(secondsRat, timebase).Add(secondsRat, framesRat)
 
 	// Then round the result and extract the numerator to get the actual frame count.
 	frames := internal.RoundRat(framesRat).Num().Int64()
-	if framerate.NTSC() == rate.NTSCDrop {
-		adjustment, err := dropFrameParseAdjustment(
-			sections,
-			framerate,
-		)
+	if isDrop {
+		adjustment, err := dropFrameParseAdjustment(sections, timebase.Num().Int64())
 		if err != nil {
-			return Timecode{}, err
+			return 0, err
 		}
 		frames += adjustment
 	}
 
-	// If this was a negative value, we need to make the frames negative.
-	isNegative := match[timecodeRegexNegative] != ""
-	if isNegative {
-		frames = -frames
-	}
-
-	// Now we can use our FromFrames conversion.
-	return FromFrames(frames, framerate), nil
+	return frames, nil
 }
 
-func tcSectionsFromMatch(match []string) TimecodeSections {
-	// The hours, minutes, and seconds place are only optionally present, and annoyingly with the way regex works,
-	// will shift what group they match two depending on which ones are present. We need to put them into a
-	// slice, and pop them off the end.
-	sectionsMatched := make([]string, 0, 3)
-	for _, sectionIndex := range []int{timecodeRegexSection1, timecodeRegexSection2, timecodeRegexSection3} {
-		if section := match[sectionIndex]; section != "" {
-			sectionsMatched = append(sectionsMatched, section)
+// partialFrameFromMatch returns the fraction of a frame represented by the field or
+// subframes suffix of a timecode match.
+func partialFrameFromMatch(match []string) (*big.Rat, error) {
+	if fieldStr := match[timecodeRegexField]; fieldStr != "" {
+		field, _ := strconv.ParseInt(fieldStr, 10, 64)
+		if field >= fieldsPerFrame {
+			return nil, fieldError(
+				ErrBadSubframeValue,
+				fieldField,
+				"field %v exceeds the %v fields in a frame",
+				field,
+				fieldsPerFrame,
+			)
 		}
+		return big.NewRat(field, fieldsPerFrame), nil
 	}
 
-	sections := TimecodeSections{}
-
-	if len(sectionsMatched) >= 1 {
-		secondsStr := sectionsMatched[len(sectionsMatched)-1]
-		sections.Seconds, _ = strconv.ParseInt(secondsStr, 10, 64)
+	if subframesStr := match[timecodeRegexSubframes]; subframesStr != "" {
+		subframes, _ := strconv.ParseInt(subframesStr, 10, 64)
+		if subframes >= SubframesPerFrame {
+			return nil, fieldError(
+				ErrBadSubframeValue,
+				fieldSubframes,
+				"subframes field %v exceeds the %v subframes in a frame",
+				subframes,
+				SubframesPerFrame,
+			)
+		}
+		return big.NewRat(subframes, SubframesPerFrame), nil
 	}
-	if len(sectionsMatched) >= 2 {
-		minutesStr := sectionsMatched[len(sectionsMatched)-2]
-		sections.Minutes, _ = strconv.ParseInt(minutesStr, 10, 64)
+
+	return new(big.Rat), nil
+}
+
+// tcSectionsFromMatch returns the sections of a timecode matched by pattern.
+func tcSectionsFromMatch(match []string, pattern *notationPattern) TimecodeSections {
+	places := pattern.timecodePlaces(func(group int) bool { return match[group] != "" })
+
+	values := make([]int64, len(places))
+	for i, group := range places {
+		if group != -1 {
+			values[i], _ = strconv.ParseInt(match[group], 10, 64)
+		}
 	}
-	if len(sectionsMatched) >= 3 {
-		hoursStr := sectionsMatched[len(sectionsMatched)-3]
-		sections.Hours, _ = strconv.ParseInt(hoursStr, 10, 64)
+
+	sections := TimecodeSections{
+		Days:    values[0],
+		Hours:   values[1],
+		Minutes: values[2],
+		Seconds: values[3],
 	}
 
 	framesStr := match[timecodeRegexFrames]
@@ -140,12 +339,7 @@ func tcSectionsFromMatch(match []string) TimecodeSections {
 	return sections
 }
 
-// runtimeRegex is the regex we are going to use to parse runtimes.
-var runtimeRegex = regexp.MustCompile(
-	`^(?P<negative>-)?((?P<section1>[0-9]+)[:|;])?((?P<section2>[0-9]+)[:|;])?(?P<seconds>[0-9]+(\.[0-9]+)?)$`,
-)
-
-// Indexes of our submatch groups.
+// Indexes of the submatch groups of a runtime regex compiled by Notation.compile.
 const (
 	runtimeRegexNegative = 1
 	runtimeRegexSection1 = 3
@@ -153,39 +347,34 @@ const (
 	runtimeRegexSeconds  = 6
 )
 
-// FromRuntime parses a new timecode from a runtime string like "01:12:34.342".
+// FromRuntime parses a new timecode from a runtime string like "01:12:34.342". Use
+// Notation.FromRuntime to parse other dialects, like "01:12:34,342".
+//
+// Errors are returned as a *ParseError.
 func FromRuntime(runtime string, framerate rate.Framerate) (Timecode, error) {
-	// See if our regex gets a match
-	match := runtimeRegex.FindStringSubmatch(runtime)
-	if match == nil {
-		return Timecode{}, ErrFormatNotRecognized
-	}
+	return NotationSMPTE.FromRuntime(runtime, framerate)
+}
 
-	// The hours, and minutes, places are only optionally present, and annoyingly with
-	// the way regex works, will shift what group they match two depending on which ones
-	// are present. We need to put them into a slice, and pop them off the end.
-	sections := make([]string, 0, 2)
-	for _, sectionIndex := range []int{runtimeRegexSection1, runtimeRegexSection2} {
-		if section := match[sectionIndex]; section != "" {
-			sections = append(sections, section)
-		}
-	}
+// runtimeFromMatch returns the Timecode of a runtime matched by pattern.
+func runtimeFromMatch(match []string, pattern *notationPattern, framerate rate.Framerate) Timecode {
+	places := pattern.runtimePlaces(match)
 
-	var minutes int64
 	var hours int64
-	if len(sections) >= 1 {
-		minutesStr := sections[len(sections)-1]
-		minutes, _ = strconv.ParseInt(minutesStr, 10, 64)
+	var minutes int64
+	if group := places[0]; group != -1 {
+		hours, _ = strconv.ParseInt(match[group], 10, 64)
 	}
-	if len(sections) >= 2 {
-		hoursStr := sections[len(sections)-2]
-		hours, _ = strconv.ParseInt(hoursStr, 10, 64)
+	if group := places[1]; group != -1 {
+		minutes, _ = strconv.ParseInt(match[group], 10, 64)
 	}
 
 	secondsInt := hours*secondsPerHour + minutes*secondsPerMinute
 
 	// This value will always be here if the regex matches, we don't need to check.
 	secondsStr := match[runtimeRegexSeconds]
+	if decimal := pattern.notation.DecimalMark; decimal != "" {
+		secondsStr = strings.Replace(secondsStr, decimal, ".", 1)
+	}
 	seconds, _ := new(big.Rat).SetString(secondsStr)
 	seconds.Add(seconds, big.NewRat(secondsInt, 1))
 
@@ -195,7 +384,7 @@ func FromRuntime(runtime string, framerate rate.Framerate) (Timecode, error) {
 		seconds = seconds.Neg(seconds)
 	}
 
-	return FromSeconds(seconds, framerate), nil
+	return FromSeconds(seconds, framerate)
 }
 
 // feetAndFramesRegex will be used to parse our feet and frames value.
@@ -208,20 +397,28 @@ const (
 	fafRegexFrames   = 3
 )
 
-// FromFeetAndFrames parses a timecode from a feet+frames string like
+// FromFeetAndFrames parses a timecode from a 35mm, 4-perf feet+frames string like
+// '5400+13'.
 func FromFeetAndFrames(faf string, framerate rate.Framerate) (Timecode, error) {
-	// See if our regex gets a match
-	match := feetAndFramesRegex.FindStringSubmatch(faf)
-	if match == nil {
-		return Timecode{}, ErrFormatNotRecognized
+	return FromFeetAndFramesFor(faf, Film35mm4Perf, framerate)
+}
+
+// FromFeetAndFramesFor parses a timecode from a feet+frames string like '5400+13',
+// counted on film of the given format.
+//
+// Parsing errors are returned as a *ParseError.
+func FromFeetAndFramesFor(faf string, format FilmFormat, framerate rate.Framerate) (Timecode, error) {
+	if err := format.Validate(); err != nil {
+		return Timecode{}, err
 	}
 
-	feet, _ := strconv.ParseInt(match[fafRegexFeet], 10, 64)
-	frames, _ := strconv.ParseInt(match[fafRegexFrames], 10, 64)
+	feet, frames, isNegative, err := parseFeetAndFrames(faf)
+	if err != nil {
+		return Timecode{}, withInput(err, faf, FormatFeetAndFrames, nil)
+	}
 
-	frames += feet * framesPerFoot
+	frames = joinFeetAndFrames(feet, frames, format)
 	// If this was a negative value, we need to make the frames negative.
-	isNegative := match[fafRegexNegative] != ""
 	if isNegative {
 		frames = -frames
 	}
@@ -229,14 +426,82 @@ func FromFeetAndFrames(faf string, framerate rate.Framerate) (Timecode, error) {
 	return FromFrames(frames, framerate), nil
 }
 
-// ticksDivisor holds an inverted version of premiereTicksPerSecondsRat for dividing.
-var ticksDivisor = new(big.Rat).Inv(premiereTicksPerSecondsRat)
+// FromFeetAndPerfsFor parses a timecode from a feet+perfs string like '5400+02',
+// counted on film of the given format. See Timecode.FeetAndPerfsFor for more
+// information on the format.
+//
+// ErrBadPerfValue is returned if the value does not land on the first perf of a
+// frame. Parsing errors are returned as a *ParseError.
+func FromFeetAndPerfsFor(fap string, format FilmFormat, framerate rate.Framerate) (Timecode, error) {
+	if err := format.Validate(); err != nil {
+		return Timecode{}, err
+	}
+
+	feet, perfs, isNegative, err := parseFeetAndFrames(fap)
+	if err != nil {
+		return Timecode{}, withInput(err, fap, FormatFeetAndFrames, nil)
+	}
+
+	perfs += feet * format.PerfsPerFoot()
+	if perfs%format.PerfsPerFrame() != 0 {
+		err := fieldError(
+			ErrBadPerfValue,
+			fieldPerfs,
+			"perf %v is not a multiple of %v perfs-per-frame",
+			perfs,
+			format.PerfsPerFrame(),
+		)
+		return Timecode{}, withInput(
+			err, fap, FormatFeetAndFrames, feetAndFramesOffsets(fap, fieldPerfs),
+		)
+	}
+
+	// If this was a negative value, we need to make the perfs negative.
+	if isNegative {
+		perfs = -perfs
+	}
+
+	return FromPerfs(perfs, format, framerate), nil
+}
+
+// FromPerfs converts a count of film perforations on film of the given format to a
+// Timecode value.
+//
+// If perfs does not land on the first perf of a frame, it will be rounded down to the
+// frame it is in.
+func FromPerfs(perfs int64, format FilmFormat, framerate rate.Framerate) Timecode {
+	perFrame := format.PerfsPerFrame()
+
+	frames := perfs / perFrame
+	// Integer division truncates towards zero, so we need to step back a frame for
+	// negative values that are not on a frame boundary.
+	if perfs < 0 && perfs%perFrame != 0 {
+		frames--
+	}
+
+	return FromFrames(frames, framerate)
+}
+
+// parseFeetAndFrames parses the feet and frames places of a feet+frames string, and
+// whether it has a negative sign.
+func parseFeetAndFrames(faf string) (feet int64, frames int64, isNegative bool, err error) {
+	// See if our regex gets a match
+	match := feetAndFramesRegex.FindStringSubmatch(faf)
+	if match == nil {
+		return 0, 0, false, notRecognized("expected feet+frames like '5400+00'")
+	}
+
+	feet, _ = strconv.ParseInt(match[fafRegexFeet], 10, 64)
+	frames, _ = strconv.ParseInt(match[fafRegexFrames], 10, 64)
+	isNegative = match[fafRegexNegative] != ""
+
+	return feet, frames, isNegative, nil
+}
 
 // FromPremiereTicks returns a new Timecode from a number of Adobe Premiere Pro Ticks.
+// This is the same as FromTicks with TickBasePremiere.
 //
 // The resulting timecode will be rounded to the nearest whole-frame, given framerate.
 func FromPremiereTicks(ticks int64, framerate rate.Framerate) Timecode {
-	ticksRat := big.NewRat(ticks, 1)
-	secondsRat := ticksRat.Mul(ticksRat, ticksDivisor)
-	return FromSeconds(secondsRat, framerate)
+	return FromTicks(ticks, TickBasePremiere, framerate)
 }
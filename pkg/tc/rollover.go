@@ -0,0 +1,116 @@
+package tc
+
+import (
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"math/big"
+)
+
+// Rollover is an enum-like type for specifying how a Timecode handles values of 24
+// hours or more, and values below 0.
+type Rollover int
+
+const (
+	// RolloverNone lets hours count up without bound, and shows negative values with a
+	// '-' sign: '-00:00:01:00'. This is the default.
+	RolloverNone Rollover = iota
+	// Rollover24h wraps values at 24:00:00:00 like a deck or LTC generator, so
+	// '-00:00:01:00' becomes '23:59:59:00' and '24:00:00:00' becomes '00:00:00:00'.
+	Rollover24h
+	// RolloverDays adds an explicit day place to the front of the timecode, so
+	// '25:00:00:00' becomes '1:01:00:00:00'.
+	RolloverDays
+)
+
+// String implements fmt.Stringer.
+func (rollover Rollover) String() string {
+	switch rollover {
+	case RolloverNone:
+		return "unbounded"
+	case Rollover24h:
+		return "24h"
+	case RolloverDays:
+		return "days"
+	default:
+		return "[INVALID ROLLOVER]"
+	}
+}
+
+// hoursPerDay is the number of hours in a timecode day.
+const hoursPerDay int64 = 24
+
+// Rollover returns the Rollover policy of the Timecode.
+func (tc Timecode) Rollover() Rollover {
+	return tc.rollover
+}
+
+// WithRollover returns a copy of the Timecode which uses the given Rollover policy for
+// formatting and arithmetic.
+//
+// When using Rollover24h, the returned value is wrapped into the range
+// [00:00:00:00, 24:00:00:00), and the result of all arithmetic will be wrapped in the
+// same way. The day length is measured in timecode rather than real-world time, so
+// NTSC timecode wraps at 24:00:00:00, not after 24 real-world hours.
+func (tc Timecode) WithRollover(rollover Rollover) Timecode {
+	return Timecode{
+		seconds:  tc.Seconds(),
+		rate:     tc.rate,
+		rollover: rollover,
+	}.wrapped()
+}
+
+// withSeconds returns a new Timecode with the framerate and Rollover policy of tc, and
+// the given seconds value.
+func (tc Timecode) withSeconds(seconds *big.Rat) Timecode {
+	return Timecode{
+		seconds:  seconds,
+		rate:     tc.rate,
+		rollover: tc.rollover,
+	}.wrapped()
+}
+
+// wrapped returns tc wrapped into a single day if it uses Rollover24h.
+func (tc Timecode) wrapped() Timecode {
+	if tc.rollover != Rollover24h {
+		return tc
+	}
+
+	dayLength := tc.dayLength()
+
+	// We want a floored modulo so that negative values wrap back from the end of the
+	// day.
+	days := new(big.Rat).Quo(tc.seconds, dayLength)
+	daysFloor := new(big.Int).Div(days.Num(), days.Denom())
+
+	offset := new(big.Rat).SetInt(daysFloor)
+	offset.Mul(offset, dayLength)
+
+	tc.seconds = new(big.Rat).Sub(tc.seconds, offset)
+	return tc
+}
+
+// dayLength returns the real-world seconds it takes for the Timecode's framerate to
+// reach 24:00:00:00.
+func (tc Timecode) dayLength() *big.Rat {
+	// 24:00:00:00 is always a valid drop-frame value, so we do not need to check the
+	// error.
+	frames, _ := framesFromSections(
+		TimecodeSections{Hours: hoursPerDay},
+		tc.rate.Timebase(),
+		tc.rate.NTSC() == rate.NTSCDrop,
+	)
+
+	seconds := big.NewRat(frames, 1)
+	return seconds.Quo(seconds, tc.rate.Playback())
+}
+
+// splitDays moves whole days out of the hours place of sections if the Timecode uses
+// RolloverDays.
+func (tc Timecode) splitDays(sections TimecodeSections) TimecodeSections {
+	if tc.rollover != RolloverDays {
+		return sections
+	}
+
+	sections.Days = sections.Hours / hoursPerDay
+	sections.Hours = sections.Hours % hoursPerDay
+	return sections
+}
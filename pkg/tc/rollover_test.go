@@ -0,0 +1,79 @@
+package tc_test
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestTimecode_WithRollover(t *testing.T) {
+	cases := []struct {
+		In       string
+		Rate     rate.Framerate
+		Rollover tc.Rollover
+		Expected string
+	}{
+		{In: "-00:00:01:00", Rate: rate.F24, Rollover: tc.RolloverNone, Expected: "-00:00:01:00"},
+		{In: "-00:00:01:00", Rate: rate.F24, Rollover: tc.Rollover24h, Expected: "23:59:59:00"},
+		{In: "24:00:00:00", Rate: rate.F24, Rollover: tc.Rollover24h, Expected: "00:00:00:00"},
+		{In: "49:00:00:01", Rate: rate.F24, Rollover: tc.Rollover24h, Expected: "01:00:00:01"},
+		{In: "-48:00:00:01", Rate: rate.F24, Rollover: tc.Rollover24h, Expected: "23:59:59:23"},
+		{In: "-00:00:00;01", Rate: rate.F29_97Df, Rollover: tc.Rollover24h, Expected: "23:59:59;29"},
+		{In: "24:00:00;00", Rate: rate.F29_97Df, Rollover: tc.Rollover24h, Expected: "00:00:00;00"},
+		{In: "-00:00:00:01", Rate: rate.F23_98, Rollover: tc.Rollover24h, Expected: "23:59:59:23"},
+		{In: "25:00:00:00", Rate: rate.F24, Rollover: tc.RolloverDays, Expected: "1:01:00:00:00"},
+		{In: "01:00:00:00", Rate: rate.F24, Rollover: tc.RolloverDays, Expected: "0:01:00:00:00"},
+		{In: "-49:00:00:00", Rate: rate.F24, Rollover: tc.RolloverDays, Expected: "-2:01:00:00:00"},
+		{In: "1:00:00:00:00", Rate: rate.F24, Rollover: tc.RolloverNone, Expected: "24:00:00:00"},
+		{In: "1:00:00:00;00", Rate: rate.F29_97Df, Rollover: tc.RolloverDays, Expected: "1:00:00:00;00"},
+	}
+
+	for _, testCase := range cases {
+		name := fmt.Sprintf("%v %v %v", testCase.In, testCase.Rate, testCase.Rollover)
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			timecode := mustTC(testCase.In, testCase.Rate).WithRollover(testCase.Rollover)
+			assert.Equal(testCase.Expected, timecode.Timecode(), "timecode")
+			assert.Equal(testCase.Rollover, timecode.Rollover(), "rollover")
+
+			parsed := mustTC(testCase.Expected, testCase.Rate).WithRollover(testCase.Rollover)
+			assert.Equal(tc.CmpEq, timecode.Cmp(parsed), "round trip")
+		})
+	}
+}
+
+func TestTimecode_Rollover24hArithmetic(t *testing.T) {
+	assert := assert.New(t)
+
+	timecode := mustTC("23:59:00:00", rate.F24).WithRollover(tc.Rollover24h)
+	minutes := mustTC("00:02:00:00", rate.F24)
+
+	assert.Equal("00:01:00:00", timecode.Add(minutes).Timecode(), "add")
+	assert.Equal(tc.Rollover24h, timecode.Add(minutes).Rollover(), "add keeps rollover")
+	assert.Equal("23:57:00:00", timecode.Sub(minutes).Timecode(), "sub")
+	assert.Equal("00:01:00:00", timecode.Neg().Timecode(), "neg")
+	assert.Equal("23:35:00:00", timecode.Mul(big.NewRat(25, 1)).Timecode(), "mul")
+	assert.Equal(tc.Rollover24h, timecode.Rebase(rate.F48).Rollover(), "rebase keeps rollover")
+
+	midnight := mustTC("00:00:30:00", rate.F24).WithRollover(tc.Rollover24h)
+	assert.Equal("23:59:30:00", midnight.Sub(mustTC("00:01:00:00", rate.F24)).Timecode(), "cross midnight")
+}
+
+func TestTimecode_RolloverDaysSections(t *testing.T) {
+	timecode := mustTC("50:01:02:03", rate.F24).WithRollover(tc.RolloverDays)
+
+	assert.Equal(
+		t,
+		tc.TimecodeSections{Days: 2, Hours: 2, Minutes: 1, Seconds: 2, Frames: 3},
+		timecode.Sections(),
+	)
+}
+
+func TestRollover_String(t *testing.T) {
+	assert.Equal(t, "24h", tc.Rollover24h.String())
+	assert.Equal(t, "[INVALID ROLLOVER]", tc.Rollover(100).String())
+}
@@ -0,0 +1,66 @@
+package tc
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/internal"
+	"github.com/wadey/go-rounding"
+	"math/big"
+)
+
+// Rounding is an enum-like type for picking how a Timecode is rounded when it is
+// converted to a whole number of some smaller unit, like nanoseconds or ticks.
+type Rounding int
+
+const (
+	// RoundNearest rounds to the nearest whole unit, with halves rounded away from zero.
+	RoundNearest Rounding = iota
+	// RoundTruncate rounds towards zero, like time.Duration.Truncate.
+	RoundTruncate
+	// RoundFloor rounds towards negative infinity, so the unit never starts after the
+	// Timecode.
+	RoundFloor
+	// RoundCeil rounds towards positive infinity, so the unit never starts before the
+	// Timecode.
+	RoundCeil
+	// RoundExact returns an error rather than rounding.
+	RoundExact
+)
+
+// String implements fmt.Stringer.
+func (mode Rounding) String() string {
+	switch mode {
+	case RoundNearest:
+		return "nearest"
+	case RoundTruncate:
+		return "truncate"
+	case RoundFloor:
+		return "floor"
+	case RoundCeil:
+		return "ceil"
+	case RoundExact:
+		return "exact"
+	default:
+		return "[INVALID ROUNDING]"
+	}
+}
+
+// round rounds value to a whole number in-place and returns it. ok is false if mode is
+// RoundExact and value is not a whole number.
+//
+// Note: this method will panic if mode is not one of the Rounding values.
+func (mode Rounding) round(value *big.Rat) (rounded *big.Rat, ok bool) {
+	switch mode {
+	case RoundNearest:
+		return internal.RoundRat(value), true
+	case RoundTruncate:
+		return rounding.Round(value, 0, rounding.Down), true
+	case RoundFloor:
+		return rounding.Round(value, 0, rounding.Floor), true
+	case RoundCeil:
+		return rounding.Round(value, 0, rounding.Ceil), true
+	case RoundExact:
+		return value, value.IsInt()
+	default:
+		panic(fmt.Sprintf("unknown Rounding %d", mode))
+	}
+}
@@ -0,0 +1,137 @@
+package tc
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/internal"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"math/big"
+	"strconv"
+)
+
+// SampleRate is the number of audio samples in a second, in Hz.
+type SampleRate int64
+
+// Common audio sample rates.
+const (
+	// SampleRate44k1 is the 44.1 kHz sample rate of CD audio.
+	SampleRate44k1 SampleRate = 44100
+	// SampleRate48k is the 48 kHz sample rate used with video.
+	SampleRate48k SampleRate = 48000
+	// SampleRate96k is the 96 kHz sample rate used for high resolution recording.
+	SampleRate96k SampleRate = 96000
+	// SampleRate192k is the 192 kHz sample rate used for high resolution recording.
+	SampleRate192k SampleRate = 192000
+)
+
+// String implements fmt.Stringer: (ex: 44.1 kHz).
+func (sampleRate SampleRate) String() string {
+	return strconv.FormatFloat(float64(sampleRate)/1000, 'f', -1, 64) + " kHz"
+}
+
+// TickBase returns a TickBase with one tick per sample, for use with Timecode.Ticks
+// when a rounding mode other than RoundNearest is needed.
+//
+// Note: this method will panic if sampleRate is not positive.
+func (sampleRate SampleRate) TickBase() TickBase {
+	checkSampleRate(sampleRate)
+	return mustTickBase(1, int64(sampleRate))
+}
+
+// checkSampleRate panics if sampleRate is not positive.
+func checkSampleRate(sampleRate SampleRate) {
+	if sampleRate <= 0 {
+		panic(fmt.Sprintf("sample rate %d must be positive", sampleRate))
+	}
+}
+
+/*
+Samples returns the number of elapsed audio samples at sampleRate this timecode
+represents, rounded to the nearest sample.
+
+What it is
+
+Audio is counted in samples rather than frames. At 24 fps and 48 kHz every frame is
+exactly 2000 samples long, but at NTSC rates frames often do not land on a sample: at
+29.97 fps and 48 kHz a frame lasts 1601.6 samples, so frames are 1602, 1601, 1602, 1601
+and 1602 samples long in a repeating five-frame sequence. Samples are worked out from
+the exact rational Seconds, so rounding never builds up over long values. See
+SampleCadence and Timecode.FrameSamples.
+
+Where you see it
+
+• The TimeReference field of a Broadcast WAV 'bext' chunk, which holds the sample
+offset of the start of the file since midnight.
+
+• AAF and OMF compositions, which place audio with sample offsets.
+
+• Sample counters in DAWs like Pro Tools.
+
+Note: this method will panic if sampleRate is not positive.
+*/
+func (tc Timecode) Samples(sampleRate SampleRate) int64 {
+	// Rounding to the nearest sample can never fail.
+	samples, _ := tc.TicksBig(sampleRate.TickBase(), RoundNearest)
+	return samples.Int64()
+}
+
+// FromSamples returns a new Timecode from a number of elapsed audio samples at
+// sampleRate.
+//
+// The resulting timecode will be rounded to the nearest whole-frame, given framerate.
+//
+// Note: this function will panic if sampleRate is not positive.
+func FromSamples(samples int64, sampleRate SampleRate, framerate rate.Framerate) Timecode {
+	return FromTicks(samples, sampleRate.TickBase(), framerate)
+}
+
+// FrameSamples returns the sample offset of the start of the frame this timecode is
+// in, and the number of samples until the start of the next frame. The boundaries are
+// rounded to the nearest sample, like Samples.
+//
+// Note: this method will panic if sampleRate is not positive.
+func (tc Timecode) FrameSamples(sampleRate SampleRate) (start int64, count int64) {
+	// Frames would round to the nearest frame, but we want the frame that holds this
+	// timecode.
+	framesRat := tc.Seconds()
+	framesRat, _ = RoundFloor.round(framesRat.Mul(framesRat, tc.rate.Playback()))
+	frames := framesRat.Num().Int64()
+
+	start = FromFrames(frames, tc.rate).Samples(sampleRate)
+	end := FromFrames(frames+1, tc.rate).Samples(sampleRate)
+	return start, end - start
+}
+
+// SamplesPerFrame returns the exact number of audio samples at sampleRate in a single
+// frame of framerate: (ex: 8008/5 for 29.97 fps at 48 kHz).
+//
+// Note: this function will panic if sampleRate is not positive.
+func SamplesPerFrame(framerate rate.Framerate, sampleRate SampleRate) *big.Rat {
+	checkSampleRate(sampleRate)
+	samples := big.NewRat(int64(sampleRate), 1)
+	return samples.Quo(samples, framerate.Playback())
+}
+
+// SampleCadence returns the number of audio samples at sampleRate in each frame of the
+// repeating sequence that starts at frame 0: (ex: [1602 1601 1602 1601 1602] for 29.97
+// fps at 48 kHz, and [2000] for 24 fps at 48 kHz).
+//
+// The sequence is as long as it takes for a frame to land on a whole sample, which for
+// some pairs of rates can be thousands of frames.
+//
+// Note: this function will panic if sampleRate is not positive.
+func SampleCadence(framerate rate.Framerate, sampleRate SampleRate) []int64 {
+	perFrame := SamplesPerFrame(framerate, sampleRate)
+	length := perFrame.Denom().Int64()
+
+	cadence := make([]int64, length)
+	previous := int64(0)
+	boundary := new(big.Rat)
+	for i := range cadence {
+		boundary.Mul(perFrame, big.NewRat(int64(i+1), 1))
+		next := internal.RoundRat(new(big.Rat).Set(boundary)).Num().Int64()
+		cadence[i] = next - previous
+		previous = next
+	}
+
+	return cadence
+}
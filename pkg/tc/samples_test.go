@@ -0,0 +1,147 @@
+package tc_test
+
+import (
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestTimecode_Samples(t *testing.T) {
+	cases := []struct {
+		Timecode   string
+		Rate       rate.Framerate
+		SampleRate tc.SampleRate
+		Expected   int64
+	}{
+		{Timecode: "01:00:00:00", Rate: rate.F24, SampleRate: tc.SampleRate48k, Expected: 172800000},
+		{Timecode: "01:00:00:00", Rate: rate.F23_98, SampleRate: tc.SampleRate48k, Expected: 172972800},
+		{Timecode: "00:00:00:01", Rate: rate.F23_98, SampleRate: tc.SampleRate48k, Expected: 2002},
+		{Timecode: "00:00:00:01", Rate: rate.F29_97Ndf, SampleRate: tc.SampleRate48k, Expected: 1602},
+		{Timecode: "00:00:00:02", Rate: rate.F29_97Ndf, SampleRate: tc.SampleRate48k, Expected: 3203},
+		{Timecode: "00:00:00:01", Rate: rate.F23_98, SampleRate: tc.SampleRate44k1, Expected: 1839},
+		{Timecode: "00:00:00:01", Rate: rate.F24, SampleRate: tc.SampleRate96k, Expected: 4000},
+		{Timecode: "00:00:00:01", Rate: rate.F24, SampleRate: tc.SampleRate192k, Expected: 8000},
+		{Timecode: "10:00:00;00", Rate: rate.F29_97Df, SampleRate: tc.SampleRate48k, Expected: 1727998272},
+		{Timecode: "-00:00:00:01", Rate: rate.F29_97Ndf, SampleRate: tc.SampleRate48k, Expected: -1602},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Timecode+" @ "+testCase.Rate.String()+" "+testCase.SampleRate.String(), func(t *testing.T) {
+			timecode, err := tc.FromTimecode(testCase.Timecode, testCase.Rate)
+			if !assert.NoError(t, err, "parse timecode") {
+				t.FailNow()
+			}
+
+			assert.Equal(t, testCase.Expected, timecode.Samples(testCase.SampleRate), "samples")
+
+			parsed := tc.FromSamples(testCase.Expected, testCase.SampleRate, testCase.Rate)
+			assert.Equal(t, timecode.Frames(), parsed.Frames(), "round trip frames")
+		})
+	}
+}
+
+func TestTimecode_FrameSamples(t *testing.T) {
+	expected := []struct {
+		Start int64
+		Count int64
+	}{
+		{0, 1602},
+		{1602, 1601},
+		{3203, 1602},
+		{4805, 1601},
+		{6406, 1602},
+		{8008, 1602},
+	}
+
+	for frame, sequence := range expected {
+		timecode := tc.FromFrames(int64(frame), rate.F29_97Ndf)
+		start, count := timecode.FrameSamples(tc.SampleRate48k)
+		assert.Equal(t, sequence.Start, start, "frame %v start", frame)
+		assert.Equal(t, sequence.Count, count, "frame %v count", frame)
+	}
+
+	// A timecode part-way through a frame reports the frame it is in.
+	start, count := tc.FromSubframes(150, 100, rate.F29_97Ndf).FrameSamples(tc.SampleRate48k)
+	assert.Equal(t, int64(1602), start, "subframe start")
+	assert.Equal(t, int64(1601), count, "subframe count")
+}
+
+func TestSampleCadence(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Rate       rate.Framerate
+		SampleRate tc.SampleRate
+		PerFrame   *big.Rat
+		Cadence    []int64
+	}{
+		{
+			Name:       "24 @ 48k",
+			Rate:       rate.F24,
+			SampleRate: tc.SampleRate48k,
+			PerFrame:   big.NewRat(2000, 1),
+			Cadence:    []int64{2000},
+		},
+		{
+			Name:       "23.98 @ 48k",
+			Rate:       rate.F23_98,
+			SampleRate: tc.SampleRate48k,
+			PerFrame:   big.NewRat(2002, 1),
+			Cadence:    []int64{2002},
+		},
+		{
+			Name:       "29.97 @ 48k",
+			Rate:       rate.F29_97Df,
+			SampleRate: tc.SampleRate48k,
+			PerFrame:   big.NewRat(8008, 5),
+			Cadence:    []int64{1602, 1601, 1602, 1601, 1602},
+		},
+		{
+			Name:       "59.94 @ 48k",
+			Rate:       rate.F59_94Ndf,
+			SampleRate: tc.SampleRate48k,
+			PerFrame:   big.NewRat(4004, 5),
+			Cadence:    []int64{801, 801, 800, 801, 801},
+		},
+		{
+			Name:       "25 @ 44.1k",
+			Rate:       mustRate(25, rate.NTSCNone),
+			SampleRate: tc.SampleRate44k1,
+			PerFrame:   big.NewRat(1764, 1),
+			Cadence:    []int64{1764},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			perFrame := tc.SamplesPerFrame(testCase.Rate, testCase.SampleRate)
+			assert.Equal(t, testCase.PerFrame, perFrame, "samples per frame")
+
+			cadence := tc.SampleCadence(testCase.Rate, testCase.SampleRate)
+			assert.Equal(t, testCase.Cadence, cadence, "cadence")
+
+			total := int64(0)
+			for _, count := range cadence {
+				total += count
+			}
+			assert.Equal(t, perFrame.Num().Int64(), total, "cadence total")
+		})
+	}
+
+	assert.Len(t, tc.SampleCadence(rate.F23_98, tc.SampleRate44k1), 80, "23.98 @ 44.1k")
+}
+
+func TestSampleRate(t *testing.T) {
+	assert.Equal(t, "44.1 kHz", tc.SampleRate44k1.String())
+	assert.Equal(t, "48 kHz", tc.SampleRate48k.String())
+	assert.Equal(t, "1/48000", tc.SampleRate48k.TickBase().String())
+
+	ticks, err := tc.FromFrames(1, rate.F29_97Ndf).Ticks(tc.SampleRate48k.TickBase(), tc.RoundFloor)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(1601), ticks)
+	}
+
+	assert.Panics(t, func() { tc.SampleRate(0).TickBase() })
+	assert.Panics(t, func() { tc.SampleCadence(rate.F24, -48000) })
+}
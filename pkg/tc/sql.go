@@ -0,0 +1,139 @@
+package tc
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"math/big"
+)
+
+// Value implements driver.Valuer. The Timecode is stored as text in the lossless form
+// written by MarshalText, like '18018/5 @ 24000/1001 NTSC NDF', including any Rollover
+// policy.
+//
+// Text values do not sort in timecode order. Use FrameColumns to store a Timecode in
+// integer columns that the database can sort and index.
+func (tc Timecode) Value() (driver.Value, error) {
+	text, err := tc.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner for text columns written by Value. Use NullTimecode for
+// columns that may be NULL.
+func (tc *Timecode) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case string:
+		return tc.UnmarshalText([]byte(value))
+	case []byte:
+		return tc.UnmarshalText(value)
+	default:
+		return fmt.Errorf("%w: cannot scan %T into Timecode", ErrScanType, src)
+	}
+}
+
+// NullTimecode represents a Timecode that may be NULL. It implements sql.Scanner and
+// driver.Valuer, like sql.NullString.
+type NullTimecode struct {
+	Timecode Timecode
+	// Valid is true if Timecode is not NULL.
+	Valid bool
+}
+
+// Value implements driver.Valuer.
+func (null NullTimecode) Value() (driver.Value, error) {
+	if !null.Valid {
+		return nil, nil
+	}
+	return null.Timecode.Value()
+}
+
+// Scan implements sql.Scanner.
+func (null *NullTimecode) Scan(src interface{}) error {
+	if src == nil {
+		null.Timecode, null.Valid = Timecode{}, false
+		return nil
+	}
+
+	if err := null.Timecode.Scan(src); err != nil {
+		return err
+	}
+	null.Valid = true
+	return nil
+}
+
+/*
+FrameColumns holds a Timecode split into integer values for storing in separate
+database columns, so the database can sort and index timecodes by frame.
+
+The frame count is only comparable between rows with the same framerate. Values are
+rounded to the nearest whole frame, and the Rollover policy and framerate ScanType are
+not stored.
+
+Storing
+
+	cols := timecode.FrameColumns()
+	_, err := db.Exec(
+		"INSERT INTO shots (frames, rate_num, rate_denom, ntsc) VALUES (?, ?, ?, ?)",
+		cols.Args()...,
+	)
+
+Loading
+
+	cols := tc.FrameColumns{}
+	err := row.Scan(cols.Dest()...)
+	timecode, err := cols.Timecode()
+*/
+type FrameColumns struct {
+	// Frames is the value of Timecode.Frames.
+	Frames int64
+	// RateNum is the numerator of the framerate playback.
+	RateNum int64
+	// RateDenom is the denominator of the framerate playback.
+	RateDenom int64
+	// NTSC is the NTSC value of the framerate.
+	NTSC rate.NTSC
+}
+
+// FrameColumns returns the Timecode split into integer values for storing in separate
+// database columns. See FrameColumns for more information.
+func (tc Timecode) FrameColumns() FrameColumns {
+	playback := tc.rate.Playback()
+
+	return FrameColumns{
+		Frames:    tc.Frames(),
+		RateNum:   playback.Num().Int64(),
+		RateDenom: playback.Denom().Int64(),
+		NTSC:      tc.rate.NTSC(),
+	}
+}
+
+// Timecode returns the Timecode stored in the columns.
+//
+// Errors building the framerate are returned as-is, wrapping rate.ErrParseFramerate.
+func (cols FrameColumns) Timecode() (Timecode, error) {
+	if cols.RateDenom == 0 {
+		return Timecode{}, fmt.Errorf("%w: rate denominator is 0", rate.ErrParseFramerate)
+	}
+
+	framerate, err := rate.FromRat(big.NewRat(cols.RateNum, cols.RateDenom), cols.NTSC)
+	if err != nil {
+		return Timecode{}, err
+	}
+
+	return FromFrames(cols.Frames, framerate), nil
+}
+
+// Args returns the column values in order, for passing to sql.DB.Exec: frames, rate
+// numerator, rate denominator, and NTSC.
+func (cols FrameColumns) Args() []interface{} {
+	return []interface{}{cols.Frames, cols.RateNum, cols.RateDenom, int64(cols.NTSC)}
+}
+
+// Dest returns pointers to the column values in the same order as Args, for passing to
+// sql.Row.Scan.
+func (cols *FrameColumns) Dest() []interface{} {
+	return []interface{}{&cols.Frames, &cols.RateNum, &cols.RateDenom, &cols.NTSC}
+}
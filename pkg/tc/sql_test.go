@@ -0,0 +1,125 @@
+package tc_test
+
+import (
+	"database/sql/driver"
+	"errors"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTimecode_SQL(t *testing.T) {
+	for _, testCase := range encodingCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			value, err := testCase.Timecode.Value()
+			if !assert.NoError(t, err, "value") {
+				t.FailNow()
+			}
+			assert.Equal(t, testCase.Text, value, "value")
+
+			// Drivers may return text columns as a string or []byte.
+			for _, src := range []interface{}{value, []byte(value.(string))} {
+				scanned := tc.Timecode{}
+				if assert.NoError(t, scanned.Scan(src), "scan %T", src) {
+					assert.Equal(t, testCase.Timecode, scanned, "scanned")
+				}
+			}
+		})
+	}
+}
+
+func TestTimecode_Scan_BadType(t *testing.T) {
+	err := new(tc.Timecode).Scan(int64(86400))
+	assert.True(t, errors.Is(err, tc.ErrScanType), "error is ErrScanType: %v", err)
+
+	err = new(tc.Timecode).Scan(nil)
+	assert.True(t, errors.Is(err, tc.ErrScanType), "error is ErrScanType: %v", err)
+}
+
+func TestNullTimecode(t *testing.T) {
+	timecode := tc.FromFrames(86400, rate.F23_98)
+
+	value, err := tc.NullTimecode{Timecode: timecode, Valid: true}.Value()
+	assert.NoError(t, err, "value")
+	assert.Equal(t, "18018/5 @ 24000/1001 NTSC NDF", value)
+
+	scanned := tc.NullTimecode{}
+	assert.NoError(t, scanned.Scan(value), "scan")
+	assert.True(t, scanned.Valid, "valid")
+	assert.Equal(t, timecode.Frames(), scanned.Timecode.Frames(), "frames")
+
+	value, err = tc.NullTimecode{Timecode: timecode}.Value()
+	assert.NoError(t, err, "null value")
+	assert.Nil(t, value, "null value")
+
+	assert.NoError(t, scanned.Scan(nil), "scan null")
+	assert.False(t, scanned.Valid, "null valid")
+	assert.Equal(t, tc.Timecode{}, scanned.Timecode, "null timecode")
+}
+
+func TestFrameColumns(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Timecode tc.Timecode
+		Expected tc.FrameColumns
+	}{
+		{
+			Name:     "23.98",
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			Expected: tc.FrameColumns{
+				Frames: 86400, RateNum: 24000, RateDenom: 1001, NTSC: rate.NTSCNonDrop,
+			},
+		},
+		{
+			Name:     "29.97 DF Negative",
+			Timecode: tc.FromFrames(-1800, rate.F29_97Df),
+			Expected: tc.FrameColumns{
+				Frames: -1800, RateNum: 30000, RateDenom: 1001, NTSC: rate.NTSCDrop,
+			},
+		},
+		{
+			Name:     "24",
+			Timecode: tc.FromFrames(24, rate.F24),
+			Expected: tc.FrameColumns{Frames: 24, RateNum: 24, RateDenom: 1, NTSC: rate.NTSCNone},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			cols := testCase.Timecode.FrameColumns()
+			assert.Equal(t, testCase.Expected, cols, "columns")
+
+			// Every arg should be a value the database driver can store as-is.
+			for _, arg := range cols.Args() {
+				assert.True(t, driver.IsValue(arg), "%T is driver value", arg)
+			}
+
+			// Simulate a database round-trip.
+			scanned := tc.FrameColumns{}
+			for i, dest := range scanned.Dest() {
+				switch dest := dest.(type) {
+				case *int64:
+					*dest = cols.Args()[i].(int64)
+				case *rate.NTSC:
+					*dest = rate.NTSC(cols.Args()[i].(int64))
+				}
+			}
+			assert.Equal(t, cols, scanned, "scanned columns")
+
+			timecode, err := scanned.Timecode()
+			if assert.NoError(t, err, "timecode") {
+				assert.Equal(t, testCase.Timecode.Frames(), timecode.Frames(), "frames")
+				assert.Equal(t, testCase.Timecode.Rate(), timecode.Rate(), "rate")
+			}
+		})
+	}
+}
+
+func TestFrameColumns_Timecode_Errors(t *testing.T) {
+	_, err := tc.FrameColumns{Frames: 24, RateNum: 24}.Timecode()
+	assert.ErrorIs(t, err, rate.ErrParseFramerate)
+
+	_, err = tc.FrameColumns{Frames: 24, RateNum: 24, RateDenom: 1, NTSC: rate.NTSCDrop}.Timecode()
+	assert.ErrorIs(t, err, rate.ErrBadDropFrameRate)
+}
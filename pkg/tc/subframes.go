@@ -0,0 +1,145 @@
+package tc
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/wadey/go-rounding"
+	"math/big"
+)
+
+// framesRat returns the exact, possibly fractional, number of frames that have elapsed
+// between 00:00:00:00 and this timecode, made positive.
+func (tc Timecode) framesRat() *big.Rat {
+	frames := tc.rate.Playback()
+	frames.Mul(frames, tc.seconds)
+	return frames.Abs(frames)
+}
+
+// wholeFrames returns the positive count of the frame this timecode falls within, and
+// the fraction of that frame which has elapsed.
+func (tc Timecode) wholeFrames() (frames int64, fraction *big.Rat) {
+	fraction = tc.framesRat()
+	whole := rounding.Round(new(big.Rat).Set(fraction), 0, rounding.Down)
+	fraction.Sub(fraction, whole)
+	return whole.Num().Int64(), fraction
+}
+
+/*
+Subframes returns the subframe within the current frame this timecode falls on, when
+each frame is divided into divisions subframes.
+
+What it is
+
+Timecode values are normally rounded to the nearest whole frame, but values created by
+FromSubframes, FromFields or by adding Timecodes with different framerates may fall
+between two frames. Subframes reports how far into the frame such a value is, without
+rounding. A timecode halfway through a frame has a Subframes(100) of 50.
+
+Unlike Frames, the containing frame is used, rather than the nearest one, so
+'01:00:00:00+50' has a Subframes(100) of 50, but Frames and Timecode round it up to
+'01:00:00:01'. Use TimecodeSubframes to format the containing frame. For negative
+timecodes, the subframe is counted away from 00:00:00:00.
+
+Where you see it
+
+• Pro Tools, which spots audio to 1/100th of a frame: '01:00:00:00+50'.
+*/
+func (tc Timecode) Subframes(divisions int64) int64 {
+	_, fraction := tc.wholeFrames()
+	fraction.Mul(fraction, big.NewRat(divisions, 1))
+	return rounding.Round(fraction, 0, rounding.Down).Num().Int64()
+}
+
+/*
+Field returns the interlaced field within the current frame this timecode falls on.
+Returns 0 for the first field and 1 for the second.
+
+What it is
+
+Interlaced video, like 50i or 59.94i, is made up of two fields for each frame. Field
+is equivalent to Subframes(2).
+
+Frames and Timecode round to the nearest frame, so the second field of '01:00:00:00'
+reports a Timecode of '01:00:00:01'. Use Fields and TimecodeField to count by the
+containing frame.
+
+Where you see it
+
+• Broadcast deck and QC logs: '01:00:00:00.1'.
+*/
+func (tc Timecode) Field() int64 {
+	return tc.Subframes(fieldsPerFrame)
+}
+
+/*
+FieldNumber returns the field within the current frame this timecode falls on, counted
+from 1 in the order the fields are sent. ok is false if the framerate does not have
+fields: see rate.ScanType.HasFields.
+
+What it is
+
+Unlike Field, which can be used with any framerate, FieldNumber is only reported for
+framerates with a ScanType that is interlaced or PsF, like 59.94i or 23.98PsF, so
+progressive values are not mistaken for the first field of a frame. Use IsUpperField to
+find which lines of the frame the field holds.
+
+Where you see it
+
+• Broadcast QC reports, which log faults like dropouts on a specific field.
+*/
+func (tc Timecode) FieldNumber() (number int64, ok bool) {
+	if !tc.rate.ScanType().HasFields() {
+		return 0, false
+	}
+	return tc.Field() + 1, true
+}
+
+// IsUpperField returns whether the field this timecode falls on holds the upper, or
+// top, lines of the frame. The upper field is sent first, except for
+// rate.ScanInterlacedLower framerates. ok is false if the framerate does not have
+// fields, as with FieldNumber.
+func (tc Timecode) IsUpperField() (upper bool, ok bool) {
+	number, ok := tc.FieldNumber()
+	if !ok {
+		return false, false
+	}
+
+	upperFirst := tc.rate.ScanType() != rate.ScanInterlacedLower
+	return upperFirst == (number == 1), true
+}
+
+// Fields returns the number of interlaced fields that would have elapsed between
+// 00:00:00:00 and this timecode.
+func (tc Timecode) Fields() int64 {
+	frames, _ := tc.wholeFrames()
+	fields := frames*fieldsPerFrame + tc.Field()
+
+	if tc.IsNegative() {
+		return -fields
+	}
+	return fields
+}
+
+// TimecodeField returns the formatted SMPTE timecode with a field suffix:
+// (ex: 01:00:00:00.1).
+//
+// Unlike Timecode, the frames place is the frame this timecode falls within, rather than
+// the nearest one.
+func (tc Timecode) TimecodeField() string {
+	frames, _ := tc.wholeFrames()
+	sections := tc.sectionsFromFrames(frames, tc.IsNegative())
+
+	return fmt.Sprintf("%v.%d", tc.formatSections(sections), tc.Field())
+}
+
+// TimecodeSubframes returns the formatted SMPTE timecode with a Pro Tools-style suffix
+// of SubframesPerFrame subframes: (ex: 01:00:00:00+50).
+//
+// Unlike Timecode, the frames place is the frame this timecode falls within, rather than
+// the nearest one.
+func (tc Timecode) TimecodeSubframes() string {
+	frames, _ := tc.wholeFrames()
+	sections := tc.sectionsFromFrames(frames, tc.IsNegative())
+
+	return fmt.Sprintf("%v+%02d", tc.formatSections(sections), tc.Subframes(SubframesPerFrame))
+}
@@ -0,0 +1,221 @@
+package tc_test
+
+import (
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestParseTimecode_Field(t *testing.T) {
+	cases := []struct {
+		In            string
+		Rate          rate.Framerate
+		Seconds       *big.Rat
+		Field         int64
+		Fields        int64
+		TimecodeField string
+		// TimecodeNearest is the value of Timecode, which rounds to the nearest frame
+		// rather than using the containing frame like TimecodeField.
+		TimecodeNearest string
+	}{
+		{
+			In:              "01:00:00:00.0",
+			Rate:            rate.F29_97Ndf,
+			Seconds:         big.NewRat(3003*36, 30),
+			Field:           0,
+			Fields:          216000,
+			TimecodeField:   "01:00:00:00.0",
+			TimecodeNearest: "01:00:00:00",
+		},
+		{
+			In:              "01:00:00:00.1",
+			Rate:            rate.F29_97Ndf,
+			Seconds:         big.NewRat(1001*216001, 60000),
+			Field:           1,
+			Fields:          216001,
+			TimecodeField:   "01:00:00:00.1",
+			TimecodeNearest: "01:00:00:01",
+		},
+		{
+			In:              "-00:00:01:12.1",
+			Rate:            rate.F24,
+			Seconds:         big.NewRat(-73, 48),
+			Field:           1,
+			Fields:          -73,
+			TimecodeField:   "-00:00:01:12.1",
+			TimecodeNearest: "-00:00:01:13",
+		},
+		{
+			In:              "00:01:00;02.1",
+			Rate:            rate.F29_97Df,
+			Seconds:         big.NewRat(1001*3601, 60000),
+			Field:           1,
+			Fields:          3601,
+			TimecodeField:   "00:01:00;02.1",
+			TimecodeNearest: "00:01:00;03",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.In, func(t *testing.T) {
+			assert := assert.New(t)
+
+			parsed, err := tc.FromTimecode(testCase.In, testCase.Rate)
+			if !assert.NoError(err, "parse") {
+				t.FailNow()
+			}
+
+			assert.Equal(testCase.Seconds, parsed.Seconds(), "seconds")
+			assert.Equal(testCase.Field, parsed.Field(), "field")
+			assert.Equal(testCase.Fields, parsed.Fields(), "fields")
+			assert.Equal(testCase.TimecodeField, parsed.TimecodeField(), "timecode field")
+			assert.Equal(testCase.TimecodeNearest, parsed.Timecode(), "timecode")
+
+			fromFields := tc.FromFields(testCase.Fields, testCase.Rate)
+			assert.Equal(tc.CmpEq, parsed.Cmp(fromFields), "from fields")
+		})
+	}
+}
+
+func TestParseTimecode_Subframes(t *testing.T) {
+	cases := []struct {
+		In                string
+		Seconds           *big.Rat
+		Subframes         int64
+		TimecodeSubframes string
+	}{
+		{
+			In:                "01:00:00:00+00",
+			Seconds:           big.NewRat(3600, 1),
+			Subframes:         0,
+			TimecodeSubframes: "01:00:00:00+00",
+		},
+		{
+			In:                "01:00:00:00+50",
+			Seconds:           big.NewRat(172801, 48),
+			Subframes:         50,
+			TimecodeSubframes: "01:00:00:00+50",
+		},
+		{
+			In:                "01:00:00:23+99",
+			Seconds:           big.NewRat(8642399, 2400),
+			Subframes:         99,
+			TimecodeSubframes: "01:00:00:23+99",
+		},
+		{
+			In:                "-01:00:00:00+01",
+			Seconds:           big.NewRat(-8640001, 2400),
+			Subframes:         1,
+			TimecodeSubframes: "-01:00:00:00+01",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.In, func(t *testing.T) {
+			assert := assert.New(t)
+
+			parsed, err := tc.FromTimecode(testCase.In, rate.F24)
+			if !assert.NoError(err, "parse") {
+				t.FailNow()
+			}
+
+			assert.Equal(testCase.Seconds, parsed.Seconds(), "seconds")
+			assert.Equal(testCase.Subframes, parsed.Subframes(tc.SubframesPerFrame), "subframes")
+			assert.Equal(testCase.TimecodeSubframes, parsed.TimecodeSubframes(), "timecode subframes")
+		})
+	}
+}
+
+func TestFromSubframes(t *testing.T) {
+	assert := assert.New(t)
+
+	timecode := tc.FromSubframes(8640050, 100, rate.F24)
+
+	assert.Equal(big.NewRat(172801, 48), timecode.Seconds(), "seconds")
+	assert.Equal(int64(50), timecode.Subframes(100), "subframes")
+	assert.Equal(int64(5), timecode.Subframes(10), "subframes of 10")
+	assert.Equal(int64(1), timecode.Field(), "field")
+	assert.Equal("01:00:00:00+50", timecode.TimecodeSubframes(), "timecode subframes")
+}
+
+func TestFromSecondsExact(t *testing.T) {
+	assert := assert.New(t)
+
+	seconds := big.NewRat(172801, 48)
+	timecode := tc.FromSecondsExact(seconds, rate.F24)
+	seconds.SetInt64(0)
+
+	assert.Equal(big.NewRat(172801, 48), timecode.Seconds(), "seconds")
+	assert.Equal(int64(50), timecode.Subframes(100), "subframes")
+	assert.Equal("01:00:00:00+50", timecode.TimecodeSubframes(), "timecode subframes")
+	assert.Equal(tc.CmpEq, timecode.Cmp(tc.FromSubframes(8640050, 100, rate.F24)), "from subframes")
+
+	rounded := tc.FromSeconds(big.NewRat(172801, 48), rate.F24)
+	assert.Equal(big.NewRat(86401, 24), rounded.Seconds(), "from seconds rounds")
+}
+
+func TestParseTimecode_ErrSubframes(t *testing.T) {
+	cases := []string{"01:00:00:00.2", "01:00:00:00+100"}
+
+	for _, testCase := range cases {
+		t.Run(testCase, func(t *testing.T) {
+			_, err := tc.FromTimecode(testCase, rate.F24)
+			assert.ErrorIs(t, err, tc.ErrParseTimecode, "is parse err")
+			assert.ErrorIs(t, err, tc.ErrBadSubframeValue, "is correct sub err")
+		})
+	}
+}
+
+func TestTimecode_FieldNumber(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Scan   rate.ScanType
+		Fields int64
+		Number int64
+		Upper  bool
+		OK     bool
+	}{
+		{Name: "Upper First, Field 1", Scan: rate.ScanInterlacedUpper, Fields: 10, Number: 1, Upper: true, OK: true},
+		{Name: "Upper First, Field 2", Scan: rate.ScanInterlacedUpper, Fields: 11, Number: 2, Upper: false, OK: true},
+		{Name: "Lower First, Field 1", Scan: rate.ScanInterlacedLower, Fields: 10, Number: 1, Upper: false, OK: true},
+		{Name: "Lower First, Field 2", Scan: rate.ScanInterlacedLower, Fields: 11, Number: 2, Upper: true, OK: true},
+		{Name: "PsF, Field 2", Scan: rate.ScanPsF, Fields: 11, Number: 2, Upper: false, OK: true},
+		{Name: "Negative", Scan: rate.ScanInterlacedUpper, Fields: -11, Number: 2, Upper: false, OK: true},
+		{Name: "Progressive", Scan: rate.ScanProgressive, Fields: 11, OK: false},
+		{Name: "Unspecified", Scan: rate.ScanUnspecified, Fields: 11, OK: false},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			framerate, err := rate.F29_97Df.WithScanType(testCase.Scan)
+			if !assert.NoError(err, "with scan type") {
+				t.FailNow()
+			}
+			timecode := tc.FromFields(testCase.Fields, framerate)
+
+			number, ok := timecode.FieldNumber()
+			assert.Equal(testCase.OK, ok, "field number ok")
+			assert.Equal(testCase.Number, number, "field number")
+
+			upper, ok := timecode.IsUpperField()
+			assert.Equal(testCase.OK, ok, "upper ok")
+			assert.Equal(testCase.Upper, upper, "upper")
+		})
+	}
+}
+
+func TestParseWithRate_ScanType(t *testing.T) {
+	assert := assert.New(t)
+
+	timecode, err := tc.ParseWithRate("01:00:00;00 @ 59.94i TFF NTSC DF")
+	if !assert.NoError(err, "parse") {
+		t.FailNow()
+	}
+
+	assert.Equal(rate.ScanInterlacedUpper, timecode.Rate().ScanType(), "scan type")
+	assert.Equal("01:00:00;00 @ 59.94i TFF NTSC DF", timecode.String(), "string")
+}
@@ -0,0 +1,221 @@
+package tc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// templatePart is a single literal or token of a Template.
+type templatePart struct {
+	// literal is the text to write if token is 0.
+	literal string
+	// token is the rune after the '%' of a token, or 'f' for '%ff'.
+	token rune
+	// arg is the value in braces after the token, or -1 if none was given.
+	arg int
+}
+
+/*
+Template is a pre-parsed layout for formatting a Timecode, built with ParseTemplate.
+
+What it is
+
+Templates let house styles, like 'TC 01h00m00s00f', be written once rather than as a
+wrapper around Sections for each style. Templates are made up of literal text and the
+following tokens:
+
+• %H, %M, %S, %F: the hours, minutes, seconds and frames places, zero-padded to two
+digits.
+
+• %D: the days place. Only non-zero for a Timecode using RolloverDays.
+
+• %;: the frames separator: ';' for drop-frame, and ':' otherwise.
+
+• %-: a '-' if the Timecode is negative, and nothing otherwise.
+
+• %t: the full Timecode value, like '01:00:00:00'.
+
+• %n: the Frames value. %n{8} zero-pads it to 8 digits.
+
+• %r: the Runtime value. %r{3} sets the precision to 3 places, the default is 9.
+
+• %ff: the FeetAndFrames value.
+
+• %k: the PremiereTicks value.
+
+• %%: a literal '%'.
+
+The SMPTE timecode layout is '%-%H:%M:%S%;%F'.
+*/
+type Template struct {
+	source string
+	parts  []templatePart
+}
+
+// String implements fmt.Stringer, returning the source of the template.
+func (tmpl Template) String() string {
+	return tmpl.source
+}
+
+// ParseTemplate parses a template string. See Template for the tokens that can be
+// used. An error wrapping ErrParseTemplate is returned if the template contains an
+// unknown or incomplete token.
+func ParseTemplate(template string) (Template, error) {
+	parts := make([]templatePart, 0)
+	literal := strings.Builder{}
+
+	for i := 0; i < len(template); i++ {
+		if template[i] != '%' {
+			literal.WriteByte(template[i])
+			continue
+		}
+
+		if i+1 >= len(template) {
+			return Template{}, fmt.Errorf("%w: trailing '%%' at byte %v", ErrParseTemplate, i)
+		}
+
+		token := rune(template[i+1])
+		// Token length in bytes, including the '%'.
+		length := 2
+
+		switch token {
+		case '%':
+			literal.WriteByte('%')
+			i++
+			continue
+		case 'H', 'M', 'S', 'F', 'D', ';', '-', 't', 'k', 'n', 'r':
+		case 'f':
+			if i+2 >= len(template) || template[i+2] != 'f' {
+				return Template{}, fmt.Errorf(
+					"%w: unknown token '%%f' at byte %v, did you mean '%%ff'?", ErrParseTemplate, i,
+				)
+			}
+			length = 3
+		default:
+			return Template{}, fmt.Errorf(
+				"%w: unknown token '%%%c' at byte %v", ErrParseTemplate, token, i,
+			)
+		}
+
+		part := templatePart{token: token, arg: -1}
+
+		// %n and %r may be followed by an argument in braces.
+		argStart := i + length
+		if (token == 'n' || token == 'r') && argStart < len(template) && template[argStart] == '{' {
+			argEnd := strings.IndexByte(template[argStart:], '}')
+			if argEnd == -1 {
+				return Template{}, fmt.Errorf(
+					"%w: unclosed '{' at byte %v", ErrParseTemplate, argStart,
+				)
+			}
+
+			argStr := template[argStart+1 : argStart+argEnd]
+			arg, err := strconv.Atoi(argStr)
+			if err != nil || arg < 0 {
+				return Template{}, fmt.Errorf(
+					"%w: argument '%v' at byte %v must be a positive integer",
+					ErrParseTemplate,
+					argStr,
+					argStart,
+				)
+			}
+
+			part.arg = arg
+			length += argEnd + 1
+		}
+
+		if literal.Len() > 0 {
+			parts = append(parts, templatePart{literal: literal.String()})
+			literal.Reset()
+		}
+		parts = append(parts, part)
+
+		i += length - 1
+	}
+
+	if literal.Len() > 0 {
+		parts = append(parts, templatePart{literal: literal.String()})
+	}
+
+	return Template{source: template, parts: parts}, nil
+}
+
+// MustParseTemplate is like ParseTemplate, but panics if the template cannot be parsed.
+// It is meant for templates held in package-level variables.
+func MustParseTemplate(template string) Template {
+	tmpl, err := ParseTemplate(template)
+	if err != nil {
+		panic(err)
+	}
+	return tmpl
+}
+
+// Format formats tc using the template.
+func (tmpl Template) Format(tc Timecode) string {
+	// Sections are only worked out if the template has a token that needs them.
+	var sections *TimecodeSections
+	getSections := func() TimecodeSections {
+		if sections == nil {
+			tcSections := tc.Sections()
+			sections = &tcSections
+		}
+		return *sections
+	}
+
+	builder := strings.Builder{}
+
+	for _, part := range tmpl.parts {
+		switch part.token {
+		case 0:
+			builder.WriteString(part.literal)
+		case 'H':
+			builder.WriteString(fmt.Sprintf("%02d", getSections().Hours))
+		case 'M':
+			builder.WriteString(fmt.Sprintf("%02d", getSections().Minutes))
+		case 'S':
+			builder.WriteString(fmt.Sprintf("%02d", getSections().Seconds))
+		case 'F':
+			builder.WriteString(fmt.Sprintf("%02d", getSections().Frames))
+		case 'D':
+			builder.WriteString(strconv.FormatInt(getSections().Days, 10))
+		case ';':
+			builder.WriteString(tc.frameSeparator())
+		case '-':
+			if getSections().IsNegative {
+				builder.WriteString("-")
+			}
+		case 't':
+			builder.WriteString(tc.Timecode())
+		case 'n':
+			width := part.arg
+			if width < 0 {
+				width = 0
+			}
+			builder.WriteString(fmt.Sprintf("%0*d", width, tc.Frames()))
+		case 'r':
+			precision := part.arg
+			if precision < 0 {
+				precision = defaultRuntimePrecision
+			}
+			builder.WriteString(tc.Runtime(precision))
+		case 'f':
+			builder.WriteString(tc.FeetAndFrames())
+		case 'k':
+			builder.WriteString(strconv.FormatInt(tc.PremiereTicks(), 10))
+		}
+	}
+
+	return builder.String()
+}
+
+// FormatTemplate parses template and formats the Timecode with it. See Template for the
+// tokens that can be used. If the same template will be used many times, use
+// ParseTemplate and Template.Format instead.
+func (tc Timecode) FormatTemplate(template string) (string, error) {
+	tmpl, err := ParseTemplate(template)
+	if err != nil {
+		return "", err
+	}
+	return tmpl.Format(tc), nil
+}
@@ -0,0 +1,183 @@
+package tc
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"math/big"
+)
+
+/*
+TickBase is a clock that counts time in whole ticks of a fixed length, like an FFmpeg
+time_base.
+
+What it is
+
+Many formats and APIs track time as an integer count of ticks rather than frames, so
+the same count works for any framerate. The TickBase is the length of one tick in
+seconds: a 90 kHz clock has a TickBase of 1/90000.
+
+Where you see it
+
+• MPEG transport and program stream PTS and DTS values, which use a 90 kHz clock, and
+PCR values, which use a 27 MHz clock.
+
+• Matroska and WebM timestamps, which are nanoseconds by default.
+
+• FFmpeg and ffprobe stream time_base values, like 1/12800.
+
+• Adobe Premiere Pro ticks.
+
+The zero value is not a valid TickBase, and will cause a panic if used.
+*/
+type TickBase struct {
+	// timeBase is the length of a tick in seconds.
+	timeBase *big.Rat
+}
+
+var (
+	// TickBasePremiere is the 254016000000 ticks per second used by Adobe Premiere Pro.
+	// See Timecode.PremiereTicks.
+	TickBasePremiere = mustTickBase(1, 254016000000)
+	// TickBase90kHz is the 90 kHz clock of MPEG PTS and DTS values.
+	TickBase90kHz = mustTickBase(1, 90000)
+	// TickBase27MHz is the 27 MHz clock of MPEG PCR values.
+	TickBase27MHz = mustTickBase(1, 27000000)
+	// TickBaseNanoseconds counts nanoseconds, like Matroska timestamps with the default
+	// TimestampScale.
+	TickBaseNanoseconds = mustTickBase(1, nanosecondsPerSecond)
+	// TickBaseFlicks is the 705600000 flicks per second used by Facebook's Flicks
+	// library, which divide evenly into common frame and sample rates.
+	TickBaseFlicks = mustTickBase(1, 705600000)
+)
+
+// mustTickBase creates a new TickBase and panics if there is an error.
+func mustTickBase(num int64, denom int64) TickBase {
+	base, err := NewTickBase(num, denom)
+	if err != nil {
+		panic(fmt.Errorf("error creating tick base %v/%v: %w", num, denom, err))
+	}
+	return base
+}
+
+// NewTickBase creates a new TickBase where each tick lasts num/denom seconds: (ex:
+// NewTickBase(1, 12800) for an FFmpeg time_base of 1/12800.)
+//
+// ErrBadTickBase is returned if num or denom are not positive.
+func NewTickBase(num int64, denom int64) (TickBase, error) {
+	if num <= 0 || denom <= 0 {
+		return TickBase{}, fmt.Errorf("%w: %v/%v", ErrBadTickBase, num, denom)
+	}
+	return TickBase{timeBase: big.NewRat(num, denom)}, nil
+}
+
+// TickBaseFromRat creates a new TickBase where each tick lasts value seconds.
+//
+// ErrBadTickBase is returned if value is not positive.
+func TickBaseFromRat(value *big.Rat) (TickBase, error) {
+	if value.Sign() <= 0 {
+		return TickBase{}, fmt.Errorf("%w: %v", ErrBadTickBase, value.RatString())
+	}
+	return TickBase{timeBase: new(big.Rat).Set(value)}, nil
+}
+
+// ParseTickBase parses a TickBase from the length of a tick in seconds, written as a
+// fraction or decimal, like an ffprobe time_base: (ex: '1/12800' or '0.001').
+//
+// Errors are returned as a *ParseError wrapping ErrBadTickBase if value cannot be
+// parsed, or is not positive.
+func ParseTickBase(value string) (TickBase, error) {
+	timeBase, ok := new(big.Rat).SetString(value)
+	if !ok {
+		err := fieldError(ErrBadTickBase, "", "expected a fraction like '1/12800' or a decimal")
+		return TickBase{}, withInput(err, value, FormatUnknown, nil)
+	}
+
+	if timeBase.Sign() <= 0 {
+		err := fieldError(ErrBadTickBase, "", "%v is not positive", timeBase.RatString())
+		return TickBase{}, withInput(err, value, FormatUnknown, nil)
+	}
+
+	return TickBase{timeBase: timeBase}, nil
+}
+
+// CommonTickBase returns the TickBase of rate.CommonTimebase, the longest tick that
+// every frame of each of rates lasts a whole number of. Timecodes at any of rates can be
+// converted to it with Ticks and RoundExact without an error, so timecodes with mixed
+// framerates can be added and compared as integers.
+//
+// Note: this function will panic if no rates are passed, or any rate has a playback of 0.
+func CommonTickBase(rates ...rate.Framerate) TickBase {
+	return TickBase{timeBase: new(big.Rat).Inv(rate.CommonTimebase(rates...))}
+}
+
+// TimeBase returns the length of a single tick in seconds.
+func (base TickBase) TimeBase() *big.Rat {
+	return new(big.Rat).Set(base.timeBase)
+}
+
+// TicksPerSecond returns the number of ticks in a second.
+func (base TickBase) TicksPerSecond() *big.Rat {
+	return new(big.Rat).Inv(base.timeBase)
+}
+
+// String implements fmt.Stringer, formatting the length of a tick in seconds:
+// (ex: 1/90000).
+func (base TickBase) String() string {
+	return base.timeBase.RatString()
+}
+
+// Ticks returns the number of elapsed ticks of base this timecode represents, rounded
+// using mode.
+//
+// ErrInexactTicks is returned if mode is RoundExact and the timecode does not land on a
+// whole tick. ErrTicksOverflow is returned if the result does not fit in an int64; use
+// TicksBig for these values.
+//
+// Note: this method will panic if mode is not one of the Rounding values.
+func (tc Timecode) Ticks(base TickBase, mode Rounding) (int64, error) {
+	ticks, err := tc.TicksBig(base, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	if !ticks.IsInt64() {
+		return 0, fmt.Errorf("%w: %v is %v ticks of %v", ErrTicksOverflow, tc, ticks, base)
+	}
+	return ticks.Int64(), nil
+}
+
+// TicksBig works like Ticks, but returns a *big.Int, so it can hold values that would
+// overflow an int64, like long durations in a fine TickBase.
+//
+// ErrInexactTicks is returned if mode is RoundExact and the timecode does not land on a
+// whole tick.
+//
+// Note: this method will panic if mode is not one of the Rounding values.
+func (tc Timecode) TicksBig(base TickBase, mode Rounding) (*big.Int, error) {
+	ticks := tc.Seconds()
+	ticks.Quo(ticks, base.timeBase)
+
+	ticks, ok := mode.round(ticks)
+	if !ok {
+		return nil, fmt.Errorf(
+			"%w: %v is %v ticks of %v", ErrInexactTicks, tc, ticks.RatString(), base,
+		)
+	}
+
+	return new(big.Int).Set(ticks.Num()), nil
+}
+
+// FromTicks returns a new Timecode from a number of elapsed ticks of base.
+//
+// The resulting timecode will be rounded to the nearest whole-frame, given framerate.
+func FromTicks(ticks int64, base TickBase, framerate rate.Framerate) Timecode {
+	return FromTicksBig(big.NewInt(ticks), base, framerate)
+}
+
+// FromTicksBig works like FromTicks, but takes a *big.Int for values that would overflow
+// an int64.
+func FromTicksBig(ticks *big.Int, base TickBase, framerate rate.Framerate) Timecode {
+	seconds := new(big.Rat).SetInt(ticks)
+	seconds.Mul(seconds, base.timeBase)
+	return FromSeconds(seconds, framerate)
+}
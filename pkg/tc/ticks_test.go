@@ -0,0 +1,215 @@
+package tc_test
+
+import (
+	"errors"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestTimecode_Ticks(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Timecode tc.Timecode
+		Base     tc.TickBase
+		Mode     tc.Rounding
+		Expected int64
+		Err      error
+	}{
+		{
+			Name:     "premiere",
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			Base:     tc.TickBasePremiere,
+			Mode:     tc.RoundExact,
+			Expected: 915372057600000,
+		},
+		{
+			Name:     "90kHz hour",
+			Timecode: tc.FromFrames(86400, rate.F23_98),
+			Base:     tc.TickBase90kHz,
+			Mode:     tc.RoundExact,
+			Expected: 324324000,
+		},
+		{
+			Name:     "90kHz frame nearest",
+			Timecode: tc.FromFrames(1, rate.F23_98),
+			Base:     tc.TickBase90kHz,
+			Mode:     tc.RoundNearest,
+			Expected: 3754,
+		},
+		{
+			Name:     "90kHz frame truncate",
+			Timecode: tc.FromFrames(1, rate.F23_98),
+			Base:     tc.TickBase90kHz,
+			Mode:     tc.RoundTruncate,
+			Expected: 3753,
+		},
+		{
+			Name:     "90kHz negative frame floor",
+			Timecode: tc.FromFrames(-1, rate.F23_98),
+			Base:     tc.TickBase90kHz,
+			Mode:     tc.RoundFloor,
+			Expected: -3754,
+		},
+		{
+			Name:     "90kHz negative frame ceil",
+			Timecode: tc.FromFrames(-1, rate.F23_98),
+			Base:     tc.TickBase90kHz,
+			Mode:     tc.RoundCeil,
+			Expected: -3753,
+		},
+		{
+			Name:     "90kHz frame exact",
+			Timecode: tc.FromFrames(1, rate.F23_98),
+			Base:     tc.TickBase90kHz,
+			Mode:     tc.RoundExact,
+			Err:      tc.ErrInexactTicks,
+		},
+		{
+			Name:     "29.97 frame 27MHz",
+			Timecode: tc.FromFrames(1, rate.F29_97Ndf),
+			Base:     tc.TickBase27MHz,
+			Mode:     tc.RoundExact,
+			Expected: 900900,
+		},
+		{
+			Name:     "nanoseconds",
+			Timecode: tc.FromFrames(12, rate.F24),
+			Base:     tc.TickBaseNanoseconds,
+			Mode:     tc.RoundExact,
+			Expected: 500000000,
+		},
+		{
+			Name:     "flicks",
+			Timecode: tc.FromFrames(1, rate.F23_98),
+			Base:     tc.TickBaseFlicks,
+			Mode:     tc.RoundExact,
+			Expected: 29429400,
+		},
+		{
+			Name:     "ffmpeg time_base",
+			Timecode: tc.FromFrames(3, rate.F24),
+			Base:     mustTickBase("1/12800"),
+			Mode:     tc.RoundExact,
+			Expected: 1600,
+		},
+		{
+			Name:     "overflow",
+			Timecode: tc.FromFrames(86400*24*365*2, rate.F24),
+			Base:     tc.TickBasePremiere,
+			Mode:     tc.RoundNearest,
+			Err:      tc.ErrTicksOverflow,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ticks, err := testCase.Timecode.Ticks(testCase.Base, testCase.Mode)
+			if testCase.Err != nil {
+				assert.True(t, errors.Is(err, testCase.Err), "error is %v: %v", testCase.Err, err)
+				return
+			}
+
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+			assert.Equal(t, testCase.Expected, ticks, "ticks")
+
+			parsed := tc.FromTicks(ticks, testCase.Base, testCase.Timecode.Rate())
+			assert.Equal(t, testCase.Timecode.Frames(), parsed.Frames(), "round trip frames")
+		})
+	}
+}
+
+func TestTimecode_TicksBig(t *testing.T) {
+	timecode := tc.FromFrames(86400*24*365*2, rate.F24)
+
+	ticks, err := timecode.TicksBig(tc.TickBasePremiere, tc.RoundExact)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	expected, _ := new(big.Int).SetString("16021297152000000000", 10)
+	assert.Equal(t, expected, ticks)
+	assert.Equal(t, timecode.Frames(), tc.FromTicksBig(ticks, tc.TickBasePremiere, rate.F24).Frames())
+}
+
+func TestTimecode_PremiereTicks_Base(t *testing.T) {
+	timecode := tc.FromFrames(86400+7, rate.F29_97Df)
+
+	ticks, err := timecode.Ticks(tc.TickBasePremiere, tc.RoundNearest)
+	if assert.NoError(t, err) {
+		assert.Equal(t, timecode.PremiereTicks(), ticks)
+	}
+	assert.Equal(t, tc.FromPremiereTicks(ticks, rate.F29_97Df), tc.FromTicks(ticks, tc.TickBasePremiere, rate.F29_97Df))
+}
+
+func TestTickBase(t *testing.T) {
+	assert.Equal(t, "1/90000", tc.TickBase90kHz.String())
+	assert.Equal(t, big.NewRat(1, 90000), tc.TickBase90kHz.TimeBase())
+	assert.Equal(t, big.NewRat(90000, 1), tc.TickBase90kHz.TicksPerSecond())
+
+	base, err := tc.NewTickBase(1001, 30000)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1001/30000", base.String())
+	}
+
+	base, err = tc.ParseTickBase("0.001")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1/1000", base.String())
+	}
+}
+
+func TestTickBase_Errors(t *testing.T) {
+	_, err := tc.NewTickBase(0, 1)
+	assert.ErrorIs(t, err, tc.ErrBadTickBase, "zero num")
+
+	_, err = tc.NewTickBase(1, -1)
+	assert.ErrorIs(t, err, tc.ErrBadTickBase, "negative denom")
+
+	_, err = tc.TickBaseFromRat(big.NewRat(-1, 1000))
+	assert.ErrorIs(t, err, tc.ErrBadTickBase, "negative rat")
+
+	for _, value := range []string{"", "1/0", "-1/1000", "0", "time_base"} {
+		_, err = tc.ParseTickBase(value)
+		assert.ErrorIs(t, err, tc.ErrBadTickBase, "parse %q", value)
+
+		var parseErr *tc.ParseError
+		if assert.ErrorAs(t, err, &parseErr, "parse error %q", value) {
+			assert.Equal(t, value, parseErr.Input, "input %q", value)
+		}
+	}
+}
+
+func TestRounding_String(t *testing.T) {
+	assert.Equal(t, "floor", tc.RoundFloor.String())
+	assert.Equal(t, "[INVALID ROUNDING]", tc.Rounding(100).String())
+}
+
+func mustTickBase(value string) tc.TickBase {
+	base, err := tc.ParseTickBase(value)
+	if err != nil {
+		panic(err)
+	}
+	return base
+}
+
+func TestCommonTickBase(t *testing.T) {
+	assert := assert.New(t)
+
+	base := tc.CommonTickBase(rate.F23_98, rate.F25, rate.F29_97Df)
+	assert.Equal("1/120000", base.String(), "tick base")
+
+	for _, framerate := range []rate.Framerate{rate.F23_98, rate.F25, rate.F29_97Df} {
+		ticks, err := tc.FromFrames(1, framerate).Ticks(base, tc.RoundExact)
+		assert.NoError(err, "%v exact ticks", framerate)
+		assert.Greater(ticks, int64(0), "%v ticks", framerate)
+	}
+
+	mixed := tc.FromFrames(1, rate.F23_98).Add(tc.FromFrames(1, rate.F25))
+	ticks, err := mixed.Ticks(base, tc.RoundExact)
+	assert.NoError(err, "mixed exact ticks")
+	assert.Equal(int64(5005+4800), ticks, "mixed ticks")
+}
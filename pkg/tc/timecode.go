@@ -4,9 +4,7 @@ import (
 	"fmt"
 	"github.com/opencinemac/vtc-go/pkg/internal"
 	"github.com/opencinemac/vtc-go/pkg/rate"
-	"github.com/wadey/go-rounding"
 	"math/big"
-	"strings"
 )
 
 // TimecodeSections holds the individual sections of a timecode for formatting /
@@ -14,6 +12,9 @@ import (
 type TimecodeSections struct {
 	// IsNegative is whether the timecode is less than 0.
 	IsNegative bool
+	// Days is the value of the days place. It is only set for Timecode values using
+	// RolloverDays, otherwise Hours counts up without bound.
+	Days int64
 	// Hours is the value of the hours place.
 	Hours int64
 	// Minutes is the value of the hours place.
@@ -22,6 +23,11 @@ type TimecodeSections struct {
 	Seconds int64
 	// Frames is the value of the hours place.
 	Frames int64
+	// HFRIndex is the index of the frame within a group of high-frame-rate frames that
+	// share the same Frames value. It is only set by Notation.Sections for notations
+	// with HFR set, like NotationHFR, and will always be 0 for rates of 60 fps and
+	// below.
+	HFRIndex int64
 }
 
 // Timecode represents the frame at a particular time in a video.
@@ -30,6 +36,8 @@ type Timecode struct {
 	seconds *big.Rat
 	// rate holds information about our framerate.
 	rate rate.Framerate
+	// rollover holds how values of 24 hours or more, and negative values are handled.
+	rollover Rollover
 }
 
 // String implements fmt.Stringer.
@@ -71,15 +79,29 @@ func (tc Timecode) Seconds() *big.Rat {
 //
 // Note: this method will panic on framerates where the timebase is not a whole integer.
 func (tc Timecode) Sections() TimecodeSections {
-	timebase := tc.Rate().Timebase()
-
 	framesInt := tc.Frames()
 	isNegative := tc.IsNegative()
 	if isNegative {
 		framesInt = -framesInt
 	}
-	if tc.rate.NTSC() == rate.NTSCDrop {
-		framesInt += dropFrameNumAdjustment(framesInt, tc.rate)
+
+	return tc.sectionsFromFrames(framesInt, isNegative)
+}
+
+// sectionsFromFrames returns the timecode sections for a positive frame count at the
+// Timecode's framerate.
+func (tc Timecode) sectionsFromFrames(framesInt int64, isNegative bool) TimecodeSections {
+	sections := sectionsAtTimebase(
+		framesInt, isNegative, tc.rate.Timebase(), tc.rate.NTSC() == rate.NTSCDrop,
+	)
+	return tc.splitDays(sections)
+}
+
+// sectionsAtTimebase returns the timecode sections for a positive frame count at
+// timebase.
+func sectionsAtTimebase(framesInt int64, isNegative bool, timebase *big.Rat, isDrop bool) TimecodeSections {
+	if isDrop {
+		framesInt += dropFrameNumAdjustment(framesInt, timebase.Num().Int64())
 	}
 
 	frames := big.NewRat(framesInt, 1)
@@ -123,32 +145,23 @@ timecode:
 • Burned into the footage for dailies.
 
 • Cut lists like an EDL.
+
+Timecode is always written in NotationSMPTE. Use Notation.Timecode for other dialects.
 */
 func (tc Timecode) Timecode() string {
-	sections := tc.Sections()
-
-	// We'll add a negative sign if the timecode is negative.
-	sign := ""
-	if sections.IsNegative {
-		sign = "-"
-	}
+	return tc.formatSections(tc.Sections())
+}
 
-	// If this is a drop-frame timecode, we need to use a ';' to separate the frames
-	// from the seconds.
-	frameSep := ":"
-	if tc.Rate().NTSC() == rate.NTSCDrop {
-		frameSep = ";"
-	}
+// formatSections formats sections as a SMPTE timecode string using the frames
+// separator for the Timecode's framerate.
+func (tc Timecode) formatSections(sections TimecodeSections) string {
+	return NotationSMPTE.formatSections(tc, sections)
+}
 
-	return fmt.Sprintf(
-		"%v%02d:%02d:%02d%v%02d",
-		sign,
-		sections.Hours,
-		sections.Minutes,
-		sections.Seconds,
-		frameSep,
-		sections.Frames,
-	)
+// frameSeparator returns the separator between the seconds and frames places of the
+// Timecode.
+func (tc Timecode) frameSeparator() string {
+	return NotationSMPTE.frameSeparator(tc.rate)
 }
 
 /*
@@ -211,40 +224,10 @@ Where you see it
 
 	ffmpeg -ss 00:00:30.5 -i input.mov -t 00:00:10.25 output.mp4
 
+Runtime is always written in NotationSMPTE. Use Notation.Runtime for other dialects.
 */
 func (tc Timecode) Runtime(precision int) string {
-	seconds := tc.Seconds()
-	// If this is a negative value, make it positive for the purposes of parsing the
-	// value.
-	isNegative := tc.IsNegative()
-	if isNegative {
-		seconds.Neg(seconds)
-	}
-
-	hours, seconds := internal.DivModRat(seconds, secondsPerHourRat)
-	minutes, seconds := internal.DivModRat(seconds, secondsPerMinuteRat)
-
-	seconds = rounding.Round(seconds, precision, rounding.HalfUp)
-
-	var secondsStr string
-	if seconds.IsInt() {
-		secondsStr = fmt.Sprintf("%02d.0", seconds.Num().Int64())
-	} else {
-		secondsStr = seconds.FloatString(precision)
-		// Trim any trailing zeros.
-		secondsStr = strings.TrimRight(secondsStr, "0")
-		// If the seconds is less than 10, we need to pad a leading 0.
-		if seconds.Cmp(rat10) == -1 {
-			secondsStr = "0" + secondsStr
-		}
-	}
-
-	sign := ""
-	if isNegative {
-		sign = "-"
-	}
-
-	return fmt.Sprintf("%v%02d:%02d:%v", sign, hours.Num().Int64(), minutes.Num().Int64(), secondsStr)
+	return NotationSMPTE.Runtime(tc, precision)
 }
 
 /*
@@ -272,6 +255,15 @@ is often burned into the reference picture for them.
 • Sound turnover change lists.
 */
 func (tc Timecode) FeetAndFrames() string {
+	return tc.FeetAndFramesFor(Film35mm4Perf)
+}
+
+// FeetAndFramesFor returns the number of feet and frames this timecode represents if
+// it were shot on film of the given format. ex: '5400+13'.
+//
+// For formats where frames straddle feet, like 35mm 3-perf, the frames place counts
+// from the first frame that starts in the foot. See FilmFormat for more information.
+func (tc Timecode) FeetAndFramesFor(format FilmFormat) string {
 	frames := tc.Frames()
 	// If this is a negative value, make it positive.
 	isNegative := tc.IsNegative()
@@ -279,8 +271,7 @@ func (tc Timecode) FeetAndFrames() string {
 		frames = -frames
 	}
 
-	feet := frames / framesPerFoot
-	frames = frames % framesPerFoot
+	feet, frames := splitFeetAndFrames(frames, format)
 
 	sign := ""
 	if isNegative {
@@ -290,6 +281,39 @@ func (tc Timecode) FeetAndFrames() string {
 	return fmt.Sprintf("%v%v+%02d", sign, feet, frames)
 }
 
+// Perfs returns the number of film perforations that would have elapsed between
+// 00:00:00:00 and this timecode if it were shot on film of the given format.
+func (tc Timecode) Perfs(format FilmFormat) int64 {
+	return tc.Frames() * format.PerfsPerFrame()
+}
+
+// FeetAndPerfsFor returns the number of feet and perforations this timecode represents
+// if it were shot on film of the given format. ex: '5400+02'.
+//
+// Unlike FeetAndFramesFor, the value after the '+' counts perfs from the start of the
+// foot rather than frames. For formats like 35mm 3-perf, where the perfs per foot is
+// not a multiple of the perfs per frame, this records exactly where in the foot the
+// frame starts.
+func (tc Timecode) FeetAndPerfsFor(format FilmFormat) string {
+	perfs := tc.Perfs(format)
+	// If this is a negative value, make it positive.
+	isNegative := tc.IsNegative()
+	if isNegative {
+		perfs = -perfs
+	}
+
+	perFoot := format.PerfsPerFoot()
+	feet := perfs / perFoot
+	perfs = perfs % perFoot
+
+	sign := ""
+	if isNegative {
+		sign = "-"
+	}
+
+	return fmt.Sprintf("%v%v+%02d", sign, feet, perfs)
+}
+
 /*
 PremiereTicks returns the number of elapsed ticks this timecode represents in Adobe
 Premiere Pro.
@@ -298,7 +322,8 @@ What it is
 
 Internally, Adobe Premiere Pro uses ticks to divide up a second, and keep track of how
 far into that second we are. There are 254016000000 ticks in a second, regardless of
-framerate in Premiere.
+framerate in Premiere. This is the same as Ticks with TickBasePremiere, rounded to the
+nearest tick.
 
 Where you see it
 
@@ -316,7 +341,7 @@ Where you see it
 	</clipitem>
 */
 func (tc Timecode) PremiereTicks() int64 {
-	seconds := tc.Seconds()
-	ticks := seconds.Mul(seconds, premiereTicksPerSecondsRat)
-	return internal.RoundRat(ticks).Num().Int64()
+	// Rounding to the nearest tick can never fail.
+	ticks, _ := tc.TicksBig(TickBasePremiere, RoundNearest)
+	return ticks.Int64()
 }
@@ -49,28 +49,24 @@ func (tc Timecode) Cmp(other Timecode) Cmp {
 // Add adds two timecodes together using their real-world seconds values, rounded to
 // the nearest frame
 //
-// The returned timecode will contain the framerate of the calling timecode.
+// The returned timecode will contain the framerate and Rollover policy of the calling
+// timecode.
 func (tc Timecode) Add(other Timecode) Timecode {
 	seconds := tc.Seconds()
 	seconds = seconds.Add(seconds, other.seconds)
 
-	return Timecode{
-		seconds: seconds,
-		rate:    tc.rate,
-	}
+	return tc.withSeconds(seconds)
 }
 
 // Sub subtracts a timecode from the caller using their real-world seconds values.
 //
-// The returned timecode will contain the framerate of the calling timecode.
+// The returned timecode will contain the framerate and Rollover policy of the calling
+// timecode.
 func (tc Timecode) Sub(other Timecode) Timecode {
 	seconds := tc.Seconds()
 	seconds = seconds.Sub(seconds, other.seconds)
 
-	return Timecode{
-		seconds: seconds,
-		rate:    tc.rate,
-	}
+	return tc.withSeconds(seconds)
 }
 
 // Mul multiplies a timecode by a scalar.
@@ -78,10 +74,7 @@ func (tc Timecode) Mul(multiplier *big.Rat) Timecode {
 	seconds := tc.Seconds()
 	seconds = seconds.Mul(seconds, multiplier)
 
-	return Timecode{
-		seconds: seconds,
-		rate:    tc.rate,
-	}
+	return tc.withSeconds(seconds)
 }
 
 // Div divides a timecode by a scalar. Divide returns a result as if floor division had
@@ -93,7 +86,7 @@ func (tc Timecode) Div(divisor *big.Rat) Timecode {
 	frames = frames.Mul(frames, divisor)
 
 	frames = rounding.Round(frames, 0, rounding.Down)
-	return FromFrames(frames.Num().Int64(), tc.Rate())
+	return FromFrames(frames.Num().Int64(), tc.Rate()).WithRollover(tc.rollover)
 }
 
 // Mod divides a timecode by a scalar and returns the dividend and remainder. Mod
@@ -110,7 +103,9 @@ func (tc Timecode) DivMod(divisor *big.Rat) (dividend Timecode, remainder Timeco
 
 	dividendRat, remainderRat := internal.DivModRat(frames, divisor)
 	remainderRat = internal.RoundRat(remainderRat)
-	return FromFrames(dividendRat.Num().Int64(), tc.rate), FromFrames(remainderRat.Num().Int64(), tc.rate)
+	dividend = FromFrames(dividendRat.Num().Int64(), tc.rate).WithRollover(tc.rollover)
+	remainder = FromFrames(remainderRat.Num().Int64(), tc.rate).WithRollover(tc.rollover)
+	return dividend, remainder
 }
 
 // Neg returns the negative version of the timecode (will be positive if current value
@@ -119,10 +114,7 @@ func (tc Timecode) Neg() Timecode {
 	seconds := tc.Seconds()
 	seconds = seconds.Neg(seconds)
 
-	return Timecode{
-		seconds: seconds,
-		rate:    tc.rate,
-	}
+	return tc.withSeconds(seconds)
 }
 
 // Abs returns the absolute value of the Timecode.
@@ -138,5 +130,5 @@ func (tc Timecode) Abs() Timecode {
 // Framerate.
 func (tc Timecode) Rebase(framerate rate.Framerate) Timecode {
 	// Just use the FromFrames parser to parse the frame count at the new framerate.
-	return FromFrames(tc.Frames(), framerate)
+	return FromFrames(tc.Frames(), framerate).WithRollover(tc.rollover)
 }
@@ -366,3 +366,14 @@ func TestTimecode_Rebase(t *testing.T) {
 func TestCmp_StringInvalid(t *testing.T) {
 	assert.Equal(t, "[INVALID]", tc.Cmp(100).String())
 }
+
+func TestTimecode_Frames_RoundsToNearest(t *testing.T) {
+	assert := assert.New(t)
+
+	almost := tc.FromFrames(1, rate.F24).Mul(big.NewRat(99, 100))
+	assert.Equal(int64(1), almost.Frames(), "just under a frame")
+	assert.Equal("00:00:00:01", almost.Timecode(), "timecode")
+
+	under := tc.FromFrames(1, rate.F24).Mul(big.NewRat(49, 100))
+	assert.Equal(int64(0), under.Frames(), "under half a frame")
+}
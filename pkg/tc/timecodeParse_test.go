@@ -96,6 +96,26 @@ func TestParseTimecode(t *testing.T) {
 			PremiereTicks: 15256200960000,
 			FeetAndFrames: "112+08",
 		},
+		{
+			Name:          "00:01:59;29 29.97 Drop-Frame",
+			Rate:          rate.F29_97Df,
+			Seconds:       big.NewRat(3600597, 30000),
+			Frames:        3597,
+			Timecode:      "00:01:59;29",
+			Runtime:       "00:02:00.0199",
+			PremiereTicks: 30486974918400,
+			FeetAndFrames: "224+13",
+		},
+		{
+			Name:          "00:01:30;00 29.97 Drop-Frame",
+			Rate:          rate.F29_97Df,
+			Seconds:       big.NewRat(1350349, 15000),
+			Frames:        2698,
+			Timecode:      "00:01:30;00",
+			Runtime:       "00:01:30.023266667",
+			PremiereTicks: 22867350105600,
+			FeetAndFrames: "168+10",
+		},
 		{
 			Name:          "00:10:00;00 29.97 Drop-Frame",
 			Rate:          rate.F29_97Df,
@@ -478,3 +498,98 @@ func TestFromFeetAndFrames_ErrFormat(t *testing.T) {
 	assert.ErrorIs(err, tc.ErrParseTimecode, "is parse err")
 	assert.ErrorIs(err, tc.ErrFormatNotRecognized, "is correct sub err")
 }
+
+func TestParseWithRate(t *testing.T) {
+	cases := []tc.Timecode{
+		tc.FromFrames(86400, rate.F23_98),
+		tc.FromFrames(-86400, rate.F24),
+		tc.FromFrames(1800, rate.F29_97Df),
+		tc.FromFrames(3600, rate.F59_94Ndf),
+		tc.FromFrames(86400*25, rate.F24).WithRollover(tc.RolloverDays),
+	}
+
+	for _, timecode := range cases {
+		t.Run(timecode.String(), func(t *testing.T) {
+			assert := assert.New(t)
+
+			parsed, err := tc.ParseWithRate(timecode.String())
+			if !assert.NoError(err, "parse with rate") {
+				t.FailNow()
+			}
+
+			assert.Equal(timecode.String(), parsed.String(), "string")
+			assert.Equal(timecode.Rate(), parsed.Rate(), "rate")
+			assert.Equal(tc.CmpEq, timecode.Cmp(parsed), "value")
+		})
+	}
+}
+
+func TestParseWithRate_Err(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := tc.ParseWithRate("01:00:00:00")
+	assert.ErrorIs(err, tc.ErrFormatNotRecognized, "no rate")
+
+	_, err = tc.ParseWithRate("01:00:00:00 @ 23.98")
+	assert.ErrorIs(err, rate.ErrParseFramerate, "bad rate")
+
+	_, err = tc.ParseWithRate("not a timecode @ 24 fps")
+	assert.ErrorIs(err, tc.ErrFormatNotRecognized, "bad timecode")
+}
+
+func TestInferDropFrame(t *testing.T) {
+	cases := []struct {
+		Timecode    string
+		Rate        rate.Framerate
+		Expected    rate.Framerate
+		ExpectedErr error
+	}{
+		{
+			Timecode: "00:01:00;02",
+			Rate:     rate.F29_97Ndf,
+			Expected: rate.F29_97Df,
+		},
+		{
+			Timecode: "00:01:00;02",
+			Rate:     rate.F30,
+			Expected: rate.F29_97Df,
+		},
+		{
+			Timecode: "00:01:00;04",
+			Rate:     rate.F59_94Df,
+			Expected: rate.F59_94Df,
+		},
+		{
+			Timecode: "00:01:00:02",
+			Rate:     rate.F29_97Ndf,
+			Expected: rate.F29_97Ndf,
+		},
+		{
+			Timecode: "00:01:00:02",
+			Rate:     rate.F29_97Df,
+			Expected: rate.F29_97Df,
+		},
+		{
+			Timecode:    "00:01:00;02",
+			Rate:        rate.F23_98,
+			ExpectedErr: rate.ErrBadDropFrameRate,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Timecode+" "+testCase.Rate.String(), func(t *testing.T) {
+			assert := assert.New(t)
+
+			inferred, err := tc.InferDropFrame(testCase.Timecode, testCase.Rate)
+			if testCase.ExpectedErr != nil {
+				assert.ErrorIs(err, testCase.ExpectedErr, "error")
+				return
+			}
+
+			if !assert.NoError(err, "infer drop frame") {
+				t.FailNow()
+			}
+			assert.Equal(testCase.Expected, inferred, "rate")
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package tcpb
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"math/big"
+)
+
+// FromFramerate converts a rate.Framerate to its wire form.
+//
+// ErrInvalid is returned if framerate is the zero value, and ErrOverflow if the playback
+// numerator or denominator do not fit in an int64.
+func FromFramerate(framerate rate.Framerate) (*Framerate, error) {
+	if framerate.Equal(rate.Framerate{}) {
+		return nil, fmt.Errorf("%w: Framerate is the zero value", ErrInvalid)
+	}
+
+	num, denom, err := ratParts(framerate.Playback(), "playback")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Framerate{
+		PlaybackNum:   num,
+		PlaybackDenom: denom,
+		Ntsc:          NTSC(framerate.NTSC()),
+		Scan:          ScanType(framerate.ScanType()),
+	}, nil
+}
+
+// ToFramerate converts the wire form of a Framerate to a rate.Framerate.
+//
+// ErrInvalid is returned if msg is nil or has a playback denominator of 0. Errors
+// building the framerate are returned as-is, wrapping rate.ErrParseFramerate.
+func ToFramerate(msg *Framerate) (rate.Framerate, error) {
+	if msg == nil {
+		return rate.Framerate{}, fmt.Errorf("%w: Framerate is nil", ErrInvalid)
+	}
+	if msg.PlaybackDenom == 0 {
+		return rate.Framerate{}, fmt.Errorf("%w: Framerate playback_denom is 0", ErrInvalid)
+	}
+
+	framerate, err := rate.FromRat(big.NewRat(msg.PlaybackNum, msg.PlaybackDenom), rate.NTSC(msg.Ntsc))
+	if err != nil {
+		return rate.Framerate{}, err
+	}
+
+	return framerate.WithScanType(rate.ScanType(msg.Scan))
+}
+
+// FromTimecode converts a tc.Timecode to its wire form. The exact seconds,
+// framerate and Rollover policy are kept, so the conversion is lossless.
+//
+// ErrInvalid is returned if timecode is the zero value, and ErrOverflow if any numerator
+// or denominator do not fit in an int64.
+func FromTimecode(timecode tc.Timecode) (*Timecode, error) {
+	// The zero value is the only Timecode without a framerate, and has no seconds to
+	// read.
+	if timecode.Rate().Equal(rate.Framerate{}) {
+		return nil, fmt.Errorf("%w: Timecode is the zero value", ErrInvalid)
+	}
+
+	num, denom, err := ratParts(timecode.Seconds(), "seconds")
+	if err != nil {
+		return nil, err
+	}
+
+	framerate, err := FromFramerate(timecode.Rate())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Timecode{
+		SecondsNum:   num,
+		SecondsDenom: denom,
+		Rate:         framerate,
+		Rollover:     Rollover(timecode.Rollover()),
+	}, nil
+}
+
+// ToTimecode converts the wire form of a Timecode to a tc.Timecode.
+//
+// ErrInvalid is returned if msg or its rate are nil, it has a seconds denominator of 0,
+// or its Rollover is not recognized. Errors building the framerate are returned as-is,
+// wrapping rate.ErrParseFramerate.
+func ToTimecode(msg *Timecode) (tc.Timecode, error) {
+	if msg == nil {
+		return tc.Timecode{}, fmt.Errorf("%w: Timecode is nil", ErrInvalid)
+	}
+	if msg.SecondsDenom == 0 {
+		return tc.Timecode{}, fmt.Errorf("%w: Timecode seconds_denom is 0", ErrInvalid)
+	}
+
+	framerate, err := ToFramerate(msg.Rate)
+	if err != nil {
+		return tc.Timecode{}, err
+	}
+
+	if msg.Rollover < Rollover_ROLLOVER_NONE || msg.Rollover > Rollover_ROLLOVER_DAYS {
+		return tc.Timecode{}, fmt.Errorf("%w: Rollover value %v", ErrInvalid, msg.Rollover)
+	}
+
+	// FromSeconds would round the value to the nearest frame, so we keep the exact
+	// seconds instead.
+	seconds := big.NewRat(msg.SecondsNum, msg.SecondsDenom)
+	timecode := tc.FromSecondsExact(seconds, framerate)
+	return timecode.WithRollover(tc.Rollover(msg.Rollover)), nil
+}
+
+// ratParts returns the numerator and denominator of value as int64 values. name is
+// the name of the value used in errors.
+func ratParts(value *big.Rat, name string) (num int64, denom int64, err error) {
+	if !value.Num().IsInt64() || !value.Denom().IsInt64() {
+		return 0, 0, fmt.Errorf("%w: %v %v", ErrOverflow, name, value.RatString())
+	}
+	return value.Num().Int64(), value.Denom().Int64(), nil
+}
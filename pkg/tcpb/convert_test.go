@@ -0,0 +1,248 @@
+package tcpb_test
+
+import (
+	"fmt"
+	"github.com/opencinemac/vtc-go/pkg/internal/testdata"
+	"github.com/opencinemac/vtc-go/pkg/rate"
+	"github.com/opencinemac/vtc-go/pkg/tc"
+	"github.com/opencinemac/vtc-go/pkg/tcpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"math/big"
+	"testing"
+)
+
+func TestTableRoundTrip(t *testing.T) {
+	t.Run("sequence start time", func(t *testing.T) {
+		testRoundTripTimecodeInfo(t, testdata.ManyBasicEditsData.StartTime)
+	})
+
+	for i, event := range testdata.ManyBasicEditsData.Events {
+		t.Run(fmt.Sprintf("Event %03d", i), func(t *testing.T) {
+			t.Run("Record In", func(t *testing.T) {
+				testRoundTripTimecodeInfo(t, event.RecordIn)
+			})
+			t.Run("Record Out", func(t *testing.T) {
+				testRoundTripTimecodeInfo(t, event.RecordOut)
+			})
+			t.Run("Source In", func(t *testing.T) {
+				testRoundTripTimecodeInfo(t, event.SourceIn)
+			})
+			t.Run("Source Out", func(t *testing.T) {
+				testRoundTripTimecodeInfo(t, event.SourceOut)
+			})
+		})
+	}
+}
+
+func testRoundTripTimecodeInfo(t *testing.T, data testdata.TimecodeData) {
+	assert := assert.New(t)
+
+	ntsc := rate.NTSCNone
+	if data.Ntsc {
+		ntsc = rate.NTSCNonDrop
+	}
+	if data.DropFrame {
+		ntsc = rate.NTSCDrop
+	}
+
+	framerate, err := rate.FromInt(data.Timebase, ntsc)
+	if !assert.NoError(err, "parse timebase to framerate") {
+		t.FailNow()
+	}
+
+	timecode := tc.FromFrames(data.Frame, framerate)
+	decoded := roundTrip(t, timecode)
+
+	expectedSeconds := big.Rat(*data.SecondsRational)
+	assert.Equal(expectedSeconds.String(), decoded.Seconds().String(), "seconds")
+	assert.Equal(data.Timecode, decoded.Timecode(), "timecode")
+	assert.Equal(data.Frame, decoded.Frames(), "frames")
+	assert.Equal(framerate, decoded.Rate(), "rate")
+}
+
+// roundTrip converts timecode to its wire form, marshals and unmarshals it, then
+// converts it back.
+func roundTrip(t *testing.T, timecode tc.Timecode) tc.Timecode {
+	msg, err := tcpb.FromTimecode(timecode)
+	if !assert.NoError(t, err, "from timecode") {
+		t.FailNow()
+	}
+
+	data, err := proto.Marshal(msg)
+	if !assert.NoError(t, err, "marshal") {
+		t.FailNow()
+	}
+
+	unmarshalled := &tcpb.Timecode{}
+	if !assert.NoError(t, proto.Unmarshal(data, unmarshalled), "unmarshal") {
+		t.FailNow()
+	}
+
+	decoded, err := tcpb.ToTimecode(unmarshalled)
+	if !assert.NoError(t, err, "to timecode") {
+		t.FailNow()
+	}
+
+	return decoded
+}
+
+func TestRoundTrip_Lossless(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Timecode tc.Timecode
+	}{
+		{
+			Name:     "Subframes",
+			Timecode: tc.FromSubframes(2450, 100, rate.F23_98),
+		},
+		{
+			Name:     "Negative Drop Frame",
+			Timecode: tc.FromFrames(-1800, rate.F29_97Df),
+		},
+		{
+			Name:     "Rollover Days",
+			Timecode: tc.FromFrames(86400*25, rate.F24).WithRollover(tc.RolloverDays),
+		},
+		{
+			Name:     "Rollover 24h",
+			Timecode: tc.FromFrames(-24, rate.F24).WithRollover(tc.Rollover24h),
+		},
+		{
+			// The frame count of this value does not fit in an int64, but its seconds do.
+			Name:     "Large Seconds",
+			Timecode: tc.FromSecondsExact(big.NewRat(1<<62, 7), rate.F23_98),
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert.Equal(t, testCase.Timecode, roundTrip(t, testCase.Timecode))
+		})
+	}
+}
+
+func TestFramerate(t *testing.T) {
+	interlaced, err := rate.F29_97Df.WithScanType(rate.ScanInterlacedLower)
+	if !assert.NoError(t, err, "with scan type") {
+		t.FailNow()
+	}
+
+	for _, framerate := range []rate.Framerate{rate.F23_98, rate.F24, rate.F29_97Df, interlaced} {
+		t.Run(framerate.String(), func(t *testing.T) {
+			msg, err := tcpb.FromFramerate(framerate)
+			if !assert.NoError(t, err, "from framerate") {
+				t.FailNow()
+			}
+			assert.Equal(t, tcpb.NTSC(framerate.NTSC()), msg.Ntsc, "ntsc")
+			assert.Equal(t, tcpb.ScanType(framerate.ScanType()), msg.Scan, "scan")
+
+			decoded, err := tcpb.ToFramerate(msg)
+			if assert.NoError(t, err, "to framerate") {
+				assert.Equal(t, framerate, decoded)
+			}
+		})
+	}
+}
+
+func TestNTSC_MirrorsRate(t *testing.T) {
+	assert.Equal(t, int32(rate.NTSCNone), int32(tcpb.NTSC_NTSC_NONE))
+	assert.Equal(t, int32(rate.NTSCNonDrop), int32(tcpb.NTSC_NTSC_NON_DROP))
+	assert.Equal(t, int32(rate.NTSCDrop), int32(tcpb.NTSC_NTSC_DROP))
+
+	assert.Equal(t, int32(rate.ScanUnspecified), int32(tcpb.ScanType_SCAN_TYPE_UNSPECIFIED))
+	assert.Equal(t, int32(rate.ScanProgressive), int32(tcpb.ScanType_SCAN_TYPE_PROGRESSIVE))
+	assert.Equal(t, int32(rate.ScanInterlacedUpper), int32(tcpb.ScanType_SCAN_TYPE_INTERLACED_UPPER))
+	assert.Equal(t, int32(rate.ScanInterlacedLower), int32(tcpb.ScanType_SCAN_TYPE_INTERLACED_LOWER))
+	assert.Equal(t, int32(rate.ScanPsF), int32(tcpb.ScanType_SCAN_TYPE_PSF))
+
+	assert.Equal(t, int32(tc.RolloverNone), int32(tcpb.Rollover_ROLLOVER_NONE))
+	assert.Equal(t, int32(tc.Rollover24h), int32(tcpb.Rollover_ROLLOVER_24H))
+	assert.Equal(t, int32(tc.RolloverDays), int32(tcpb.Rollover_ROLLOVER_DAYS))
+}
+
+func TestToTimecode_Errors(t *testing.T) {
+	validRate := &tcpb.Framerate{PlaybackNum: 24, PlaybackDenom: 1}
+
+	cases := []struct {
+		Name string
+		Msg  *tcpb.Timecode
+		Err  error
+	}{
+		{
+			Name: "Nil",
+			Msg:  nil,
+			Err:  tcpb.ErrInvalid,
+		},
+		{
+			Name: "Zero Denominator",
+			Msg:  &tcpb.Timecode{SecondsNum: 1, Rate: validRate},
+			Err:  tcpb.ErrInvalid,
+		},
+		{
+			Name: "Nil Rate",
+			Msg:  &tcpb.Timecode{SecondsNum: 1, SecondsDenom: 1},
+			Err:  tcpb.ErrInvalid,
+		},
+		{
+			Name: "Zero Rate Denominator",
+			Msg: &tcpb.Timecode{
+				SecondsNum: 1, SecondsDenom: 1, Rate: &tcpb.Framerate{PlaybackNum: 24},
+			},
+			Err: tcpb.ErrInvalid,
+		},
+		{
+			Name: "Bad Rollover",
+			Msg:  &tcpb.Timecode{SecondsNum: 1, SecondsDenom: 1, Rate: validRate, Rollover: 7},
+			Err:  tcpb.ErrInvalid,
+		},
+		{
+			Name: "Bad NTSC",
+			Msg: &tcpb.Timecode{
+				SecondsNum:   1,
+				SecondsDenom: 1,
+				Rate:         &tcpb.Framerate{PlaybackNum: 24, PlaybackDenom: 1, Ntsc: 7},
+			},
+			Err: rate.ErrBadNtsc,
+		},
+		{
+			Name: "Bad Scan Type",
+			Msg: &tcpb.Timecode{
+				SecondsNum:   1,
+				SecondsDenom: 1,
+				Rate:         &tcpb.Framerate{PlaybackNum: 24, PlaybackDenom: 1, Scan: 7},
+			},
+			Err: rate.ErrBadScanType,
+		},
+		{
+			Name: "Bad Drop Frame",
+			Msg: &tcpb.Timecode{
+				SecondsNum:   1,
+				SecondsDenom: 1,
+				Rate: &tcpb.Framerate{
+					PlaybackNum: 24, PlaybackDenom: 1, Ntsc: tcpb.NTSC_NTSC_DROP,
+				},
+			},
+			Err: rate.ErrBadDropFrameRate,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := tcpb.ToTimecode(testCase.Msg)
+			assert.ErrorIs(t, err, testCase.Err)
+		})
+	}
+}
+
+func TestFromFramerate_ZeroValue(t *testing.T) {
+	msg, err := tcpb.FromFramerate(rate.Framerate{})
+	assert.ErrorIs(t, err, tcpb.ErrInvalid)
+	assert.Nil(t, msg, "message")
+}
+
+func TestFromTimecode_ZeroValue(t *testing.T) {
+	msg, err := tcpb.FromTimecode(tc.Timecode{})
+	assert.ErrorIs(t, err, tcpb.ErrInvalid)
+	assert.Nil(t, msg, "message")
+}
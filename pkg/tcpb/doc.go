@@ -0,0 +1,12 @@
+/*
+Package tcpb holds the Protocol Buffers wire format for tc.Timecode and rate.Framerate
+values, along with lossless conversions to and from them.
+
+The schema is defined in vtc.proto, which can be used to generate clients for other
+languages.
+*/
+package tcpb
+
+// vtc.pb.go is generated with protoc v3.21.12 and protoc-gen-go v1.28.1. Run 'make tcpb'
+// to regenerate it with those versions.
+//go:generate protoc --go_out=. --go_opt=paths=source_relative vtc.proto
@@ -0,0 +1,15 @@
+package tcpb
+
+import "errors"
+
+// tcpb comes with sentinel errors for catching conversion problems.
+var (
+	// ErrInvalid is returned when a message cannot be converted because it is missing
+	// a required value, or holds a value that is out of range. It is also returned when
+	// converting a zero value Framerate or Timecode to a message.
+	ErrInvalid = errors.New("invalid tcpb message")
+
+	// ErrOverflow is returned when a rational value is too large to be stored in the
+	// int64 fields of a message.
+	ErrOverflow = errors.New("value overflows int64 message field")
+)
@@ -0,0 +1,481 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: vtc.proto
+
+// Package vtc holds the canonical wire format for vtc-go timecode and framerate
+// values.
+
+package tcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NTSC mirrors rate.NTSC, and specifies whether a framerate adheres to the NTSC
+// standard.
+type NTSC int32
+
+const (
+	// NTSC_NONE means the framerate is not an NTSC framerate.
+	NTSC_NTSC_NONE NTSC = 0
+	// NTSC_NON_DROP means this is an NTSC, non-drop-frame framerate.
+	NTSC_NTSC_NON_DROP NTSC = 1
+	// NTSC_DROP means this is an NTSC, drop-frame framerate.
+	NTSC_NTSC_DROP NTSC = 2
+)
+
+// Enum value maps for NTSC.
+var (
+	NTSC_name = map[int32]string{
+		0: "NTSC_NONE",
+		1: "NTSC_NON_DROP",
+		2: "NTSC_DROP",
+	}
+	NTSC_value = map[string]int32{
+		"NTSC_NONE":     0,
+		"NTSC_NON_DROP": 1,
+		"NTSC_DROP":     2,
+	}
+)
+
+func (x NTSC) Enum() *NTSC {
+	p := new(NTSC)
+	*p = x
+	return p
+}
+
+func (x NTSC) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NTSC) Descriptor() protoreflect.EnumDescriptor {
+	return file_vtc_proto_enumTypes[0].Descriptor()
+}
+
+func (NTSC) Type() protoreflect.EnumType {
+	return &file_vtc_proto_enumTypes[0]
+}
+
+func (x NTSC) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NTSC.Descriptor instead.
+func (NTSC) EnumDescriptor() ([]byte, []int) {
+	return file_vtc_proto_rawDescGZIP(), []int{0}
+}
+
+// Rollover mirrors tc.Rollover, and specifies how a timecode handles values of 24
+// hours or more, and values below 0.
+type Rollover int32
+
+const (
+	// ROLLOVER_NONE lets hours count up without bound.
+	Rollover_ROLLOVER_NONE Rollover = 0
+	// ROLLOVER_24H wraps values at 24:00:00:00.
+	Rollover_ROLLOVER_24H Rollover = 1
+	// ROLLOVER_DAYS adds an explicit day place to the front of the timecode.
+	Rollover_ROLLOVER_DAYS Rollover = 2
+)
+
+// Enum value maps for Rollover.
+var (
+	Rollover_name = map[int32]string{
+		0: "ROLLOVER_NONE",
+		1: "ROLLOVER_24H",
+		2: "ROLLOVER_DAYS",
+	}
+	Rollover_value = map[string]int32{
+		"ROLLOVER_NONE": 0,
+		"ROLLOVER_24H":  1,
+		"ROLLOVER_DAYS": 2,
+	}
+)
+
+func (x Rollover) Enum() *Rollover {
+	p := new(Rollover)
+	*p = x
+	return p
+}
+
+func (x Rollover) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rollover) Descriptor() protoreflect.EnumDescriptor {
+	return file_vtc_proto_enumTypes[1].Descriptor()
+}
+
+func (Rollover) Type() protoreflect.EnumType {
+	return &file_vtc_proto_enumTypes[1]
+}
+
+func (x Rollover) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rollover.Descriptor instead.
+func (Rollover) EnumDescriptor() ([]byte, []int) {
+	return file_vtc_proto_rawDescGZIP(), []int{1}
+}
+
+// ScanType mirrors rate.ScanType, and specifies how the frames of a framerate are
+// scanned.
+type ScanType int32
+
+const (
+	// SCAN_TYPE_UNSPECIFIED means the scan type is not known.
+	ScanType_SCAN_TYPE_UNSPECIFIED ScanType = 0
+	// SCAN_TYPE_PROGRESSIVE means each frame is captured and displayed whole.
+	ScanType_SCAN_TYPE_PROGRESSIVE ScanType = 1
+	// SCAN_TYPE_INTERLACED_UPPER means interlaced fields, upper field first.
+	ScanType_SCAN_TYPE_INTERLACED_UPPER ScanType = 2
+	// SCAN_TYPE_INTERLACED_LOWER means interlaced fields, lower field first.
+	ScanType_SCAN_TYPE_INTERLACED_LOWER ScanType = 3
+	// SCAN_TYPE_PSF means progressive frames split into fields for transport.
+	ScanType_SCAN_TYPE_PSF ScanType = 4
+)
+
+// Enum value maps for ScanType.
+var (
+	ScanType_name = map[int32]string{
+		0: "SCAN_TYPE_UNSPECIFIED",
+		1: "SCAN_TYPE_PROGRESSIVE",
+		2: "SCAN_TYPE_INTERLACED_UPPER",
+		3: "SCAN_TYPE_INTERLACED_LOWER",
+		4: "SCAN_TYPE_PSF",
+	}
+	ScanType_value = map[string]int32{
+		"SCAN_TYPE_UNSPECIFIED":      0,
+		"SCAN_TYPE_PROGRESSIVE":      1,
+		"SCAN_TYPE_INTERLACED_UPPER": 2,
+		"SCAN_TYPE_INTERLACED_LOWER": 3,
+		"SCAN_TYPE_PSF":              4,
+	}
+)
+
+func (x ScanType) Enum() *ScanType {
+	p := new(ScanType)
+	*p = x
+	return p
+}
+
+func (x ScanType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScanType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vtc_proto_enumTypes[2].Descriptor()
+}
+
+func (ScanType) Type() protoreflect.EnumType {
+	return &file_vtc_proto_enumTypes[2]
+}
+
+func (x ScanType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScanType.Descriptor instead.
+func (ScanType) EnumDescriptor() ([]byte, []int) {
+	return file_vtc_proto_rawDescGZIP(), []int{2}
+}
+
+// Framerate is the rate at which video frames are played back, in
+// frames-per-second.
+type Framerate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// playback_num is the numerator of the real-world playback speed.
+	PlaybackNum int64 `protobuf:"varint,1,opt,name=playback_num,json=playbackNum,proto3" json:"playback_num,omitempty"`
+	// playback_denom is the denominator of the real-world playback speed.
+	PlaybackDenom int64 `protobuf:"varint,2,opt,name=playback_denom,json=playbackDenom,proto3" json:"playback_denom,omitempty"`
+	// ntsc is the NTSC standard the framerate adheres to.
+	Ntsc NTSC `protobuf:"varint,3,opt,name=ntsc,proto3,enum=vtc.NTSC" json:"ntsc,omitempty"`
+	// scan is the scan type of the framerate. playback is always the frame rate, even
+	// for interlaced framerates.
+	Scan ScanType `protobuf:"varint,4,opt,name=scan,proto3,enum=vtc.ScanType" json:"scan,omitempty"`
+}
+
+func (x *Framerate) Reset() {
+	*x = Framerate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vtc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Framerate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Framerate) ProtoMessage() {}
+
+func (x *Framerate) ProtoReflect() protoreflect.Message {
+	mi := &file_vtc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Framerate.ProtoReflect.Descriptor instead.
+func (*Framerate) Descriptor() ([]byte, []int) {
+	return file_vtc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Framerate) GetPlaybackNum() int64 {
+	if x != nil {
+		return x.PlaybackNum
+	}
+	return 0
+}
+
+func (x *Framerate) GetPlaybackDenom() int64 {
+	if x != nil {
+		return x.PlaybackDenom
+	}
+	return 0
+}
+
+func (x *Framerate) GetNtsc() NTSC {
+	if x != nil {
+		return x.Ntsc
+	}
+	return NTSC_NTSC_NONE
+}
+
+func (x *Framerate) GetScan() ScanType {
+	if x != nil {
+		return x.Scan
+	}
+	return ScanType_SCAN_TYPE_UNSPECIFIED
+}
+
+// Timecode is the frame at a particular time in a video, stored as the exact
+// real-world seconds elapsed since 00:00:00:00.
+type Timecode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seconds_num is the numerator of the real-world seconds.
+	SecondsNum int64 `protobuf:"varint,1,opt,name=seconds_num,json=secondsNum,proto3" json:"seconds_num,omitempty"`
+	// seconds_denom is the denominator of the real-world seconds.
+	SecondsDenom int64 `protobuf:"varint,2,opt,name=seconds_denom,json=secondsDenom,proto3" json:"seconds_denom,omitempty"`
+	// rate is the framerate of the timecode.
+	Rate *Framerate `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	// rollover is the rollover policy of the timecode.
+	Rollover Rollover `protobuf:"varint,4,opt,name=rollover,proto3,enum=vtc.Rollover" json:"rollover,omitempty"`
+}
+
+func (x *Timecode) Reset() {
+	*x = Timecode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vtc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timecode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timecode) ProtoMessage() {}
+
+func (x *Timecode) ProtoReflect() protoreflect.Message {
+	mi := &file_vtc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timecode.ProtoReflect.Descriptor instead.
+func (*Timecode) Descriptor() ([]byte, []int) {
+	return file_vtc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Timecode) GetSecondsNum() int64 {
+	if x != nil {
+		return x.SecondsNum
+	}
+	return 0
+}
+
+func (x *Timecode) GetSecondsDenom() int64 {
+	if x != nil {
+		return x.SecondsDenom
+	}
+	return 0
+}
+
+func (x *Timecode) GetRate() *Framerate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *Timecode) GetRollover() Rollover {
+	if x != nil {
+		return x.Rollover
+	}
+	return Rollover_ROLLOVER_NONE
+}
+
+var File_vtc_proto protoreflect.FileDescriptor
+
+var file_vtc_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x76, 0x74, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x76, 0x74, 0x63,
+	0x22, 0x97, 0x01, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x4e, 0x75,
+	0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x62,
+	0x61, 0x63, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x74, 0x73, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x76, 0x74, 0x63, 0x2e, 0x4e, 0x54, 0x53,
+	0x43, 0x52, 0x04, 0x6e, 0x74, 0x73, 0x63, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x63, 0x61, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x74, 0x63, 0x2e, 0x53, 0x63, 0x61, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x73, 0x63, 0x61, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x08, 0x54,
+	0x69, 0x6d, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x4e, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22, 0x0a,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x74,
+	0x63, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x29, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x74, 0x63, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76,
+	0x65, 0x72, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x2a, 0x37, 0x0a, 0x04,
+	0x4e, 0x54, 0x53, 0x43, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x54, 0x53, 0x43, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x54, 0x53, 0x43, 0x5f, 0x4e, 0x4f, 0x4e, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x54, 0x53, 0x43, 0x5f, 0x44,
+	0x52, 0x4f, 0x50, 0x10, 0x02, 0x2a, 0x42, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x56, 0x45, 0x52,
+	0x5f, 0x32, 0x34, 0x48, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x56,
+	0x45, 0x52, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x02, 0x2a, 0x93, 0x01, 0x0a, 0x08, 0x53, 0x63,
+	0x61, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a,
+	0x53, 0x43, 0x41, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4c,
+	0x41, 0x43, 0x45, 0x44, 0x5f, 0x55, 0x50, 0x50, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a,
+	0x53, 0x43, 0x41, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4c,
+	0x41, 0x43, 0x45, 0x44, 0x5f, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x43, 0x41, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x53, 0x46, 0x10, 0x04, 0x42,
+	0x28, 0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70,
+	0x65, 0x6e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x63, 0x2f, 0x76, 0x74, 0x63, 0x2d, 0x67, 0x6f,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_vtc_proto_rawDescOnce sync.Once
+	file_vtc_proto_rawDescData = file_vtc_proto_rawDesc
+)
+
+func file_vtc_proto_rawDescGZIP() []byte {
+	file_vtc_proto_rawDescOnce.Do(func() {
+		file_vtc_proto_rawDescData = protoimpl.X.CompressGZIP(file_vtc_proto_rawDescData)
+	})
+	return file_vtc_proto_rawDescData
+}
+
+var file_vtc_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_vtc_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_vtc_proto_goTypes = []interface{}{
+	(NTSC)(0),         // 0: vtc.NTSC
+	(Rollover)(0),     // 1: vtc.Rollover
+	(ScanType)(0),     // 2: vtc.ScanType
+	(*Framerate)(nil), // 3: vtc.Framerate
+	(*Timecode)(nil),  // 4: vtc.Timecode
+}
+var file_vtc_proto_depIdxs = []int32{
+	0, // 0: vtc.Framerate.ntsc:type_name -> vtc.NTSC
+	2, // 1: vtc.Framerate.scan:type_name -> vtc.ScanType
+	3, // 2: vtc.Timecode.rate:type_name -> vtc.Framerate
+	1, // 3: vtc.Timecode.rollover:type_name -> vtc.Rollover
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_vtc_proto_init() }
+func file_vtc_proto_init() {
+	if File_vtc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_vtc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Framerate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vtc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timecode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vtc_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_vtc_proto_goTypes,
+		DependencyIndexes: file_vtc_proto_depIdxs,
+		EnumInfos:         file_vtc_proto_enumTypes,
+		MessageInfos:      file_vtc_proto_msgTypes,
+	}.Build()
+	File_vtc_proto = out.File
+	file_vtc_proto_rawDesc = nil
+	file_vtc_proto_goTypes = nil
+	file_vtc_proto_depIdxs = nil
+}